@@ -0,0 +1,101 @@
+// Package clock provides the time sources used by the ulid and uuid
+// generators, so that tests can control the time IDs are generated at.
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// A Clock returns the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// Wall is the Clock returning the system time, time.Now.
+type Wall struct{}
+
+// Now implements Clock.
+func (Wall) Now() time.Time {
+	return time.Now()
+}
+
+// Func adapts a function returning the current time to a Clock.
+type Func func() time.Time
+
+// Now implements Clock.
+func (f Func) Now() time.Time {
+	return f()
+}
+
+// Fixed is a Clock that always returns the same time.
+type Fixed time.Time
+
+// Now implements Clock.
+func (f Fixed) Now() time.Time {
+	return time.Time(f)
+}
+
+// Offset is a Clock returning the time of Clock shifted by Offset.  A nil
+// Clock is the Wall clock.
+type Offset struct {
+	Clock  Clock
+	Offset time.Duration
+}
+
+// Now implements Clock.
+func (o Offset) Now() time.Time {
+	c := o.Clock
+	if c == nil {
+		c = Wall{}
+	}
+	return c.Now().Add(o.Offset)
+}
+
+// Fake is a Clock controlled by the caller.  Each call to Now returns the
+// current time of the Fake and then advances it by its step, so a step of zero
+// makes it a settable fixed clock.  Set and Advance move the time in either
+// direction, e.g. to simulate a clock regression.
+//
+// A Fake is safe for concurrent use.
+type Fake struct {
+	mu   sync.Mutex
+	now  time.Time
+	step time.Duration
+}
+
+// NewFake returns a Fake starting at start and stepping by step on every call
+// to Now.
+func NewFake(start time.Time, step time.Duration) *Fake {
+	return &Fake{now: start, step: step}
+}
+
+// Now implements Clock.
+func (f *Fake) Now() time.Time {
+	defer f.mu.Unlock()
+	f.mu.Lock()
+	t := f.now
+	f.now = f.now.Add(f.step)
+	return t
+}
+
+// Set sets the time returned by the next call to Now.
+func (f *Fake) Set(t time.Time) {
+	defer f.mu.Unlock()
+	f.mu.Lock()
+	f.now = t
+}
+
+// Advance moves the time of the Fake by d, which may be negative.
+func (f *Fake) Advance(d time.Duration) {
+	defer f.mu.Unlock()
+	f.mu.Lock()
+	f.now = f.now.Add(d)
+}
+
+// SetStep sets the duration the Fake advances by on every call to Now.
+func (f *Fake) SetStep(step time.Duration) {
+	defer f.mu.Unlock()
+	f.mu.Lock()
+	f.step = step
+}
@@ -0,0 +1,51 @@
+package errors
+
+import "fmt"
+
+// Kind identifies the kind of identifier a ParseError belongs to.
+type Kind byte
+
+const (
+	KindUUID Kind = iota + 1
+	KindULID
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindUUID:
+		return "UUID"
+	case KindULID:
+		return "ULID"
+	}
+	return fmt.Sprintf("Kind%d", int(k))
+}
+
+// ErrParse matches any ParseError with errors.Is.
+var ErrParse = ParseError{}
+
+// ParseError is returned when parsing a UUID or ULID fails on a specific
+// byte of the input.  Offset is the zero based position of Byte in the input
+// and Expected describes what should have been there.
+//
+// Err holds the sentinel error of the failure, e.g. ErrInvalidUUIDFormat or
+// ErrUlidInvalidCharacters, so errors.Is keeps working against them.
+type ParseError struct {
+	Kind     Kind
+	Offset   int
+	Byte     byte
+	Expected string
+	Err      error
+}
+
+func (e ParseError) Error() string {
+	return fmt.Sprintf("[%s] invalid character %q at position %d, expected %s", e.Kind, rune(e.Byte), e.Offset, e.Expected)
+}
+
+func (e ParseError) Unwrap() error {
+	return e.Err
+}
+
+func (e ParseError) Is(target error) bool {
+	_, ok := target.(ParseError)
+	return ok
+}
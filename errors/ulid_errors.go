@@ -19,4 +19,6 @@ var (
 	ErrUlidMonotonicOverflow = e.New("[ULID] monotonic entropy overflow")
 	// ErrUlidScanValue is returned when the value passed to scan cannot be unmarshaled into the ULID.
 	ErrUlidScanValue = e.New("[ULID] source value must be a string or byte slice")
+	// ErrUlidNotUUIDv7 is returned when converting a UUID that is not a version 7 UUID to a ULID with its timestamp.
+	ErrUlidNotUUIDv7 = e.New("[ULID] UUID is not version 7")
 )
@@ -3,13 +3,29 @@ package errors
 import (
 	e "errors"
 	"fmt"
+	"time"
 )
 
 var (
 	ErrInvalidUUIDFormat      = e.New("[UUID] invalid UUID format")
 	ErrInvalidBracketedFormat = e.New("[UUID] invalid bracketed UUID format")
+	ErrV7Overflow             = e.New("[UUID] version 7 monotonic overflow")
+	ErrTimeOutOfRange         = e.New("[UUID] time out of range")
+	ErrNoState                = e.New("[UUID] no stored generator state")
+	ErrInvalidVariant         = e.New("[UUID] variant is not RFC 9562")
+	ErrNilUUID                = e.New("[UUID] nil UUID not allowed")
+	ErrMaxUUID                = e.New("[UUID] max UUID not allowed")
+	ErrHardwareNodeID         = e.New("[UUID] node ID is a hardware address")
+	ErrEncodingOverflow       = e.New("[UUID] encoded value exceeds 128 bits")
+	ErrBufferSize             = e.New("[UUID] buffer too small")
 	ErrInvalidURNPrefix       = URNPrefixError{}
 	ErrInvalidLength          = InvalidLengthError{}
+	ErrInvalidV8Layout        = V8LayoutError{}
+	ErrV8FieldOverflow        = V8FieldOverflowError{}
+	ErrNamespaceRegistered    = NamespaceRegisteredError{}
+	ErrClockRegression        = ClockRegressionError{}
+	ErrInvalidVersion         = InvalidVersionError{}
+	ErrNoLocalID              = NoLocalIDError{}
 )
 
 type URNPrefixError struct {
@@ -37,3 +53,94 @@ func (e InvalidLengthError) Is(target error) bool {
 	_, ok := target.(InvalidLengthError)
 	return ok
 }
+
+// V8LayoutError is returned when a Version 8 layout does not fit into the 122
+// custom bits of a UUID.
+type V8LayoutError struct {
+	Bits int
+}
+
+func (e V8LayoutError) Error() string {
+	return fmt.Sprintf("[UUID] invalid version 8 layout: %d bits", e.Bits)
+}
+
+func (e V8LayoutError) Is(target error) bool {
+	_, ok := target.(V8LayoutError)
+	return ok
+}
+
+// V8FieldOverflowError is returned when a value does not fit into its field of
+// a Version 8 layout.
+type V8FieldOverflowError struct {
+	Field string
+	Bits  int
+}
+
+func (e V8FieldOverflowError) Error() string {
+	return fmt.Sprintf("[UUID] %s does not fit in %d bits", e.Field, e.Bits)
+}
+
+func (e V8FieldOverflowError) Is(target error) bool {
+	_, ok := target.(V8FieldOverflowError)
+	return ok
+}
+
+// NamespaceRegisteredError is returned when registering a namespace under a
+// name that is already taken by a different UUID.
+type NamespaceRegisteredError struct {
+	Name string
+}
+
+func (e NamespaceRegisteredError) Error() string {
+	return fmt.Sprintf("[UUID] namespace already registered: %q", e.Name)
+}
+
+func (e NamespaceRegisteredError) Is(target error) bool {
+	_, ok := target.(NamespaceRegisteredError)
+	return ok
+}
+
+// ClockRegressionError is returned when the clock went backwards by Delta
+// while generating a time-based UUID with the error regression policy.
+type ClockRegressionError struct {
+	Delta time.Duration
+}
+
+func (e ClockRegressionError) Error() string {
+	return fmt.Sprintf("[UUID] clock went backwards by %s", e.Delta)
+}
+
+func (e ClockRegressionError) Is(target error) bool {
+	_, ok := target.(ClockRegressionError)
+	return ok
+}
+
+// InvalidVersionError is returned when a UUID does not have the version an
+// operation requires.
+type InvalidVersionError struct {
+	Version byte
+}
+
+func (e InvalidVersionError) Error() string {
+	return fmt.Sprintf("[UUID] unexpected UUID version: %d", e.Version)
+}
+
+func (e InvalidVersionError) Is(target error) bool {
+	_, ok := target.(InvalidVersionError)
+	return ok
+}
+
+// NoLocalIDError is returned when no local ID is available for a DCE Security
+// domain.
+type NoLocalIDError struct {
+	Domain byte
+}
+
+func (e NoLocalIDError) Error() string {
+	return fmt.Sprintf("[UUID] no local ID for domain %d", e.Domain)
+}
+
+func (e NoLocalIDError) Is(target error) bool {
+	_, ok := target.(NoLocalIDError)
+	return ok
+}
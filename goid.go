@@ -22,6 +22,10 @@ func UUIDv3(name string) uuid.UUID {
 	return uuid.NewV3(name)
 }
 
+func UUIDv3In(ns uuid.UUID, name string) uuid.UUID {
+	return uuid.NewV3In(ns, name)
+}
+
 func UUIDv4() uuid.UUID {
 	return uuid.NewV4()
 }
@@ -30,6 +34,10 @@ func UUIDv5(name string) uuid.UUID {
 	return uuid.NewV5(name)
 }
 
+func UUIDv5In(ns uuid.UUID, name string) uuid.UUID {
+	return uuid.NewV5In(ns, name)
+}
+
 func UUIDv6() uuid.UUID {
 	return uuid.NewV6()
 }
@@ -37,3 +45,15 @@ func UUIDv6() uuid.UUID {
 func UUIDv7() uuid.UUID {
 	return uuid.NewV7()
 }
+
+func UUIDv8(custom [16]byte) uuid.UUID {
+	return uuid.NewV8(custom)
+}
+
+func ULIDToUUID(id ulid.ULID) uuid.UUID {
+	return id.UUID()
+}
+
+func UUIDToULID(u uuid.UUID) ulid.ULID {
+	return *ulid.FromUUID(u)
+}
@@ -0,0 +1,7 @@
+// Package crockford holds the Crockford base32 alphabet shared by the ulid and
+// uuid packages, which cannot import each other in both directions.
+package crockford
+
+// Alphabet is Douglas Crockford's base32 alphabet.  It excludes I, L, O and U
+// and is in ascending ASCII order, so encoded values sort like their bytes.
+const Alphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
@@ -49,7 +49,8 @@ func MustNewDefault(t time.Time) *ULID {
 }
 
 // Make returns a ULID with the current time in Unix milliseconds and monotonically increasing entropy for the same millisecond.
-// It is safe for concurrent use, leveraging a sync.Pool underneath for minimal contention.
+// It is safe for concurrent use. All calls are serialized through the mutex of DefaultEntropy to keep ULIDs of the same millisecond
+// strictly increasing across goroutines. For high throughput generation on many cores, see Generator.
 func Make() *ULID {
 	// NOTE: MustNew can't panic since DefaultEntropy never returns an error.
 	return MustNew(Now(), defaultEntropy)
@@ -69,12 +70,22 @@ func Parse(ulid string) (*ULID, error) {
 // It is like Parse, but additionally validates that the parsed ULID consists only of valid base32 characters. It is slightly slower than Parse.
 //
 // ErrDataSize is returned if the len(ulid) is different from an encoded ULID's length.
-// Invalid encodings return ErrInvalidCharacters.
+// Invalid encodings return an errors.ParseError with the offset of the first invalid character, which matches
+// ErrInvalidCharacters.
 func ParseStrict(ulid string) (*ULID, error) {
 	var id ULID
 	return &id, parse([]byte(ulid), true, &id)
 }
 
+// Validate returns an error if ulid is not a valid encoded ULID, like ParseStrict but without allocating a ULID.
+//
+// errors.ErrUlidDataSize is returned if the len(ulid) is different from an encoded ULID's length.  Invalid characters
+// return an errors.ParseError matching errors.ErrUlidInvalidCharacters, a first character above '7' one matching
+// errors.ErrUlidOverflow.
+func Validate(ulid string) error {
+	return validate(ulid, true)
+}
+
 // MustParse is a convenience function equivalent to Parse that panics on failure instead of returning an error.
 func MustParse(ulid string) *ULID {
 	id, er := Parse(ulid)
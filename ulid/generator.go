@@ -0,0 +1,117 @@
+package ulid
+
+import (
+	"io"
+	"sync"
+
+	"github.com/fajarnugraha37/goid/clock"
+)
+
+// A Generator produces ULIDs with monotonically increasing entropy for the
+// same millisecond.  It is safe for concurrent use.
+//
+// By default a Generator keeps a separate monotonic entropy source per
+// processor (through a sync.Pool), so concurrent calls do not contend on a
+// single lock.  ULIDs are then only monotonic per source: two ULIDs generated
+// in the same millisecond by different goroutines, or by one goroutine that
+// migrated between processors, may be out of order.  Use
+// WithGlobalMonotonicity when all ULIDs of a millisecond must be strictly
+// increasing, which serializes generation like Make does.
+type Generator struct {
+	clock   clock.Clock
+	entropy func() io.Reader
+	inc     uint64
+	global  bool
+
+	mu     sync.Mutex        // protects strict
+	strict *MonotonicEntropy // entropy source used with global monotonicity
+	pool   sync.Pool         // of *MonotonicEntropy
+}
+
+// An Option configures a Generator created by NewGenerator.
+type Option func(*Generator)
+
+// WithClock sets the Clock used by the Generator to read the current time.  By
+// default the wall clock is used.
+func WithClock(c clock.Clock) Option {
+	return func(g *Generator) {
+		if c != nil {
+			g.clock = c
+		}
+	}
+}
+
+// WithEntropy sets the function creating the entropy sources of the
+// Generator.  It is called once per source, each returned reader is only used
+// by one goroutine at a time.  By default SecureEntropy is used.
+func WithEntropy(entropy func() io.Reader) Option {
+	return func(g *Generator) {
+		if entropy != nil {
+			g.entropy = entropy
+		}
+	}
+}
+
+// WithMonotonicIncrement sets the maximum random increment of the entropy
+// within the same millisecond, see Monotonic.
+func WithMonotonicIncrement(inc uint64) Option {
+	return func(g *Generator) {
+		g.inc = inc
+	}
+}
+
+// WithGlobalMonotonicity makes the Generator use a single monotonic entropy
+// source guarded by a mutex, so that all ULIDs generated within the same
+// millisecond are strictly increasing regardless of the calling goroutine.
+func WithGlobalMonotonicity() Option {
+	return func(g *Generator) {
+		g.global = true
+	}
+}
+
+// NewGenerator returns a Generator configured with opts.
+func NewGenerator(opts ...Option) *Generator {
+	g := &Generator{clock: clock.Wall{}, entropy: SecureEntropy}
+	for _, opt := range opts {
+		opt(g)
+	}
+	g.pool.New = func() any {
+		return Monotonic(g.entropy(), g.inc)
+	}
+	if g.global {
+		g.strict = Monotonic(g.entropy(), g.inc)
+	}
+	return g
+}
+
+// New returns a ULID with the current time of the Generator's Clock in Unix
+// milliseconds and entropy that is monotonically increasing for the same
+// millisecond.
+// ErrMonotonicOverflow is returned if the entropy of the millisecond is
+// exhausted.
+func (g *Generator) New() (*ULID, error) {
+	return g.NewWithTime(Timestamp(g.clock.Now()))
+}
+
+// NewWithTime is like New but uses the given Unix milliseconds timestamp.
+func (g *Generator) NewWithTime(ms uint64) (*ULID, error) {
+	if g.global {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		return New(ms, g.strict)
+	}
+
+	m := g.pool.Get().(*MonotonicEntropy)
+	id, err := New(ms, m)
+	g.pool.Put(m)
+	return id, err
+}
+
+// Make is like New but panics on failure instead of returning an error.
+func (g *Generator) Make() *ULID {
+	id, err := g.New()
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
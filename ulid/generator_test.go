@@ -0,0 +1,34 @@
+package ulid
+
+import "testing"
+
+func BenchmarkMake(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = Make()
+		}
+	})
+}
+
+func BenchmarkGenerator(b *testing.B) {
+	for _, bench := range []struct {
+		name string
+		opts []Option
+	}{
+		{"Sharded", nil},
+		{"GlobalMonotonicity", []Option{WithGlobalMonotonicity()}},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			g := NewGenerator(bench.opts...)
+			b.ReportAllocs()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if _, err := g.New(); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		})
+	}
+}
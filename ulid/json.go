@@ -0,0 +1,178 @@
+package ulid
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fajarnugraha37/goid/errors"
+	"github.com/fajarnugraha37/goid/uuid"
+)
+
+// A JSONFormat is the representation of a ULID in JSON.
+type JSONFormat int32
+
+// JSON representations of a ULID.
+const (
+	JSONCanonical JSONFormat = iota // "01AN4Z07BY79KA1307SR9X4MV3"
+	JSONHex                         // "015549f01d7e3a66a08c07ce13d25363"
+	JSONUUID                        // "015549f0-1d7e-3a66-a08c-07ce13d25363", see ULID.UUID
+	JSONBase64                      // padded standard base64, like encoding/json encodes []byte
+	JSONBase62                      // 22 characters, see uuid.UUID.Base62
+)
+
+func (f JSONFormat) String() string {
+	switch f {
+	case JSONCanonical:
+		return "canonical"
+	case JSONHex:
+		return "hex"
+	case JSONUUID:
+		return "uuid"
+	case JSONBase64:
+		return "base64"
+	case JSONBase62:
+		return "base62"
+	}
+	return fmt.Sprintf("JSONFormat%d", int(f))
+}
+
+// A JSONFormatter names a JSONFormat at the type level, so that Formatted values know their format even when
+// unmarshaled into a zero value.
+type JSONFormatter interface {
+	JSONFormat() JSONFormat
+}
+
+// Type arguments of Formatted and NullFormatted, one per JSONFormat.
+type (
+	CanonicalJSON struct{}
+	HexJSON       struct{}
+	UUIDJSON      struct{}
+	Base64JSON    struct{}
+	Base62JSON    struct{}
+)
+
+func (CanonicalJSON) JSONFormat() JSONFormat { return JSONCanonical }
+func (HexJSON) JSONFormat() JSONFormat       { return JSONHex }
+func (UUIDJSON) JSONFormat() JSONFormat      { return JSONUUID }
+func (Base64JSON) JSONFormat() JSONFormat    { return JSONBase64 }
+func (Base62JSON) JSONFormat() JSONFormat    { return JSONBase62 }
+
+// AppendJSON appends the JSON string of id in format f to b.
+func (f JSONFormat) AppendJSON(b []byte, id ULID) []byte {
+	b = append(b, '"')
+	switch f {
+	case JSONHex:
+		b = hex.AppendEncode(b, id[:])
+	case JSONUUID:
+		b, _ = uuid.UUID(id).AppendText(b)
+	case JSONBase64:
+		b = base64.StdEncoding.AppendEncode(b, id[:])
+	case JSONBase62:
+		b = append(b, uuid.UUID(id).Base62()...)
+	default:
+		b, _ = id.AppendText(b)
+	}
+	return append(b, '"')
+}
+
+// ParseJSON decodes the JSON string data into a ULID. Besides the canonical 26 character form, every UUID format
+// accepted by uuid.ParseWithOptions in lenient mode is accepted, whatever f is, except for 22 character strings: base62
+// and unpadded base64 cannot be told apart, so they are read as base62 if f is JSONBase62, as base64 if f is JSONBase64
+// and rejected with ErrUlidDataSize otherwise.
+func (f JSONFormat) ParseJSON(data []byte) (ULID, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return Zero, err
+	}
+
+	var id ULID
+	if len(s) == EncodedSize {
+		return id, parse([]byte(s), false, &id)
+	}
+	var (
+		u   uuid.UUID
+		err error
+	)
+	switch t := strings.TrimSpace(s); {
+	case len(t) != uuid.Base62Size || f == JSONBase64:
+		u, _, err = uuid.ParseWithOptions(s, uuid.ParseOptions{Mode: uuid.ParseModeLenient})
+	case f == JSONBase62:
+		u, err = uuid.ParseBase62(t)
+	default:
+		return Zero, errors.ErrUlidDataSize
+	}
+	return ULID(u), err
+}
+
+// MarshalJSON implements json.Marshaler. ULIDs are always marshaled in the canonical form, use Formatted for other
+// formats.
+func (id ULID) MarshalJSON() ([]byte, error) {
+	return JSONCanonical.AppendJSON(make([]byte, 0, EncodedSize+2), id), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, see JSONFormat.ParseJSON. A JSON null leaves id unchanged.
+func (id *ULID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	v, err := JSONCanonical.ParseJSON(data)
+	if err != nil {
+		return err
+	}
+	*id = v
+	return nil
+}
+
+// Formatted is a ULID marshaled to JSON in the format named by F, e.g. Formatted[HexJSON].
+type Formatted[F JSONFormatter] struct {
+	ULID ULID
+}
+
+// MarshalJSON implements json.Marshaler.
+func (fu Formatted[F]) MarshalJSON() ([]byte, error) {
+	var f F
+	return f.JSONFormat().AppendJSON(make([]byte, 0, 48), fu.ULID), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves fu unchanged.
+func (fu *Formatted[F]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var f F
+	id, err := f.JSONFormat().ParseJSON(data)
+	if err != nil {
+		return err
+	}
+	fu.ULID = id
+	return nil
+}
+
+// NullFormatted is a NullULID marshaled to JSON in the format named by F.
+type NullFormatted[F JSONFormatter] struct {
+	ULID  ULID
+	Valid bool // Valid is true if ULID is not NULL
+}
+
+// MarshalJSON implements json.Marshaler.
+func (nu NullFormatted[F]) MarshalJSON() ([]byte, error) {
+	if !nu.Valid {
+		return jsonNull, nil
+	}
+	return Formatted[F]{ULID: nu.ULID}.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (nu *NullFormatted[F]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*nu = NullFormatted[F]{}
+		return nil
+	}
+	var fu Formatted[F]
+	err := fu.UnmarshalJSON(data)
+	nu.ULID, nu.Valid = fu.ULID, err == nil
+	return err
+}
@@ -0,0 +1,70 @@
+package ulid
+
+import (
+	"encoding/json"
+	e "errors"
+	"testing"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+func TestParseJSON(t *testing.T) {
+	want := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+	for _, f := range []JSONFormat{JSONCanonical, JSONHex, JSONUUID, JSONBase64, JSONBase62} {
+		data := f.AppendJSON(nil, *want)
+		got, err := f.ParseJSON(data)
+		if err != nil || got != *want {
+			t.Errorf("%v.ParseJSON(%s) = %v, %v, want %v", f, data, got, err, want)
+		}
+	}
+	for _, f := range []JSONFormat{JSONCanonical, JSONHex, JSONUUID} {
+		if _, err := f.ParseJSON(JSONBase62.AppendJSON(nil, *want)); !e.Is(err, errors.ErrUlidDataSize) {
+			t.Errorf("%v.ParseJSON(base62) = %v, want ErrUlidDataSize", f, err)
+		}
+	}
+}
+
+func TestFormattedJSON(t *testing.T) {
+	type order struct {
+		ID   Formatted[Base62JSON]  `json:"id"`
+		Ref  NullFormatted[HexJSON] `json:"ref"`
+		Plan ULID                   `json:"plan"`
+	}
+	id := *MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+	in := order{ID: Formatted[Base62JSON]{ULID: id}, Ref: NullFormatted[HexJSON]{ULID: id, Valid: true}, Plan: id}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"id":"` + id.UUID().Base62() + `","ref":"015549f01d7e3a66a08c07ce13d25363","plan":"01AN4Z07BY79KA1307SR9X4MV3"}`
+	if string(data) != want {
+		t.Fatalf("Marshal = %s, want %s", data, want)
+	}
+	var out order
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out != in {
+		t.Errorf("Unmarshal = %+v, want %+v", out, in)
+	}
+}
+
+func TestUnmarshalJSONMalformed(t *testing.T) {
+	data := []byte(`"AAAAAAAAAAAAAAAAAAAAAAA="`)
+	var id ULID
+	if err := json.Unmarshal(data, &id); err == nil {
+		t.Errorf("Unmarshal into ULID = %v, want an error", id)
+	}
+	var nu NullULID
+	if err := json.Unmarshal(data, &nu); err == nil || nu.Valid {
+		t.Errorf("Unmarshal into NullULID = %+v, %v, want an error", nu, err)
+	}
+	var fu Formatted[Base64JSON]
+	if err := json.Unmarshal(data, &fu); err == nil {
+		t.Errorf("Unmarshal into Formatted = %v, want an error", fu.ULID)
+	}
+	var nf NullFormatted[Base64JSON]
+	if err := json.Unmarshal(data, &nf); err == nil || nf.Valid {
+		t.Errorf("Unmarshal into NullFormatted = %+v, %v, want an error", nf, err)
+	}
+}
@@ -1,16 +1,20 @@
 package ulid
 
-import "github.com/fajarnugraha37/goid/errors"
+import (
+	"slices"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface by returning the ULID as a byte slice.
-func (id *ULID) MarshalBinary() ([]byte, error) {
+func (id ULID) MarshalBinary() ([]byte, error) {
 	ulid := make([]byte, len(id))
 	return ulid, id.MarshalBinaryTo(ulid)
 }
 
 // MarshalBinaryTo writes the binary encoding of the ULID to the given buffer.
 // ErrBufferSize is returned when the len(dst) != 16.
-func (id *ULID) MarshalBinaryTo(dst []byte) error {
+func (id ULID) MarshalBinaryTo(dst []byte) error {
 	if len(dst) != len(id) {
 		return errors.ErrUlidBufferSize
 	}
@@ -19,6 +23,11 @@ func (id *ULID) MarshalBinaryTo(dst []byte) error {
 	return nil
 }
 
+// AppendBinary implements the encoding.BinaryAppender interface (Go 1.24) by appending the 16 bytes of the ULID to b.
+func (id ULID) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, id[:]...), nil
+}
+
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface by copying the passed data and converting it to a ULID.
 // ErrDataSize is returned if the data length is different from ULID length.
 func (id *ULID) UnmarshalBinary(data []byte) error {
@@ -31,14 +40,24 @@ func (id *ULID) UnmarshalBinary(data []byte) error {
 }
 
 // MarshalText implements the encoding.TextMarshaler interface by
-// returning the string encoded ULID.
-func (id *ULID) MarshalText() ([]byte, error) {
+// returning the string encoded ULID.  It has a value receiver, so ULIDs stored by value, e.g. in struct fields, are
+// encoded as text by encoding/json and friends.
+func (id ULID) MarshalText() ([]byte, error) {
 	ulid := make([]byte, EncodedSize)
 	return ulid, id.MarshalTextTo(ulid)
 }
 
+// AppendText implements the encoding.TextAppender interface (Go 1.24) by appending the string encoded ULID to b.
+// It does not allocate if b has room for 26 more bytes.
+func (id ULID) AppendText(b []byte) ([]byte, error) {
+	b = slices.Grow(b, EncodedSize)
+	n := len(b)
+	b = b[:n+EncodedSize]
+	return b, id.MarshalTextTo(b[n:])
+}
+
 // MarshalTextTo writes the ULID as a string to the given buffer. ErrBufferSize is returned when the len(dst) != 26.
-func (id *ULID) MarshalTextTo(dst []byte) error {
+func (id ULID) MarshalTextTo(dst []byte) error {
 	if len(dst) != EncodedSize {
 		return errors.ErrUlidBufferSize
 	}
@@ -0,0 +1,39 @@
+package ulid
+
+import "testing"
+
+func TestAppendNoAllocs(t *testing.T) {
+	id := Make()
+	buf := make([]byte, 0, 64)
+	for _, test := range []struct {
+		name string
+		f    func()
+	}{
+		{"AppendText", func() { _, _ = id.AppendText(buf) }},
+		{"AppendBinary", func() { _, _ = id.AppendBinary(buf) }},
+		{"MarshalTextTo", func() { _ = id.MarshalTextTo(buf[:EncodedSize]) }},
+		{"MarshalBinaryTo", func() { _ = id.MarshalBinaryTo(buf[:len(id)]) }},
+	} {
+		if n := testing.AllocsPerRun(100, test.f); n != 0 {
+			t.Errorf("%s allocates %v times, want 0", test.name, n)
+		}
+	}
+}
+
+func BenchmarkAppendText(b *testing.B) {
+	id := Make()
+	buf := make([]byte, 0, EncodedSize)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, _ = id.AppendText(buf[:0])
+	}
+}
+
+func BenchmarkAppendBinary(b *testing.B) {
+	id := Make()
+	buf := make([]byte, 0, len(id))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, _ = id.AppendBinary(buf[:0])
+	}
+}
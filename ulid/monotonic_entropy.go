@@ -2,11 +2,13 @@ package ulid
 
 import (
 	"bufio"
+	crand "crypto/rand"
 	"encoding/binary"
 	"io"
 	"math"
 	"math/bits"
 	"math/rand"
+	randv2 "math/rand/v2"
 	"time"
 
 	"github.com/fajarnugraha37/goid/errors"
@@ -27,17 +29,59 @@ type MonotonicEntropy struct {
 }
 
 var (
-	defaultEntropy = func() io.Reader {
-		rng := rand.New(rand.NewSource(time.Now().UnixNano()))
-		return &LockedMonotonicReader{MonotonicReader: Monotonic(rng, 0)}
-	}()
+	defaultEntropy = newDefaultEntropy(SecureEntropy())
 	// DefaultEntropy returns a thread-safe per process monotonically increasing
-	// entropy source.
+	// entropy source.  Unless replaced with SetDefaultEntropy it is backed by
+	// SecureEntropy.
 	DefaultEntropy = func() io.Reader {
 		return defaultEntropy
 	}
 )
 
+func newDefaultEntropy(entropy io.Reader) io.Reader {
+	return &LockedMonotonicReader{MonotonicReader: Monotonic(entropy, 0)}
+}
+
+// SecureEntropy returns a cryptographically secure entropy source: a ChaCha8
+// generator seeded from crypto/rand.  It is as fast as math/rand while the
+// generated ULIDs cannot be predicted by observing earlier ones or guessing the
+// process start time.
+//
+// The returned reader isn't safe for concurrent use.
+func SecureEntropy() io.Reader {
+	var seed [32]byte
+	if _, err := crand.Read(seed[:]); err != nil {
+		panic(err.Error()) // rand should never fail
+	}
+	return randv2.NewChaCha8(seed)
+}
+
+// InsecureEntropy returns a fast entropy source backed by math/rand seeded
+// with the current time.  ULIDs generated from it are predictable by anyone who
+// can guess when the source was created, it should only be used where ULIDs
+// need not be unguessable, e.g. in tests or benchmarks.
+//
+// The returned reader isn't safe for concurrent use.
+func InsecureEntropy() io.Reader {
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+// SetDefaultEntropy replaces the entropy source used by Make, MustNewDefault
+// and DefaultEntropy with a monotonic, thread-safe source reading from
+// entropy.  Passing nil restores a SecureEntropy source.  Opting in to the
+// insecure source looks like:
+//
+//	ulid.SetDefaultEntropy(ulid.InsecureEntropy())
+//
+// SetDefaultEntropy is not thread-safe and should only be called when no ULIDs
+// are being generated concurrently.
+func SetDefaultEntropy(entropy io.Reader) {
+	if entropy == nil {
+		entropy = SecureEntropy()
+	}
+	defaultEntropy = newDefaultEntropy(entropy)
+}
+
 // Monotonic returns a source of entropy that yields strictly increasing entropy
 // bytes, to a limit governeed by the `inc` parameter.
 //
@@ -0,0 +1,102 @@
+package ulid
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+var jsonNull = []byte("null")
+
+// NullULID represents a ULID that may be null.
+// NullULID implements the SQL driver.Scanner interface so
+// it can be used as a scan destination.
+type NullULID struct {
+	ULID  ULID
+	Valid bool // Valid is true if ULID is not NULL
+}
+
+// Scan implements the SQL driver.Scanner interface.
+func (nu *NullULID) Scan(value interface{}) error {
+	if value == nil {
+		nu.ULID, nu.Valid = Zero, false
+		return nil
+	}
+
+	err := nu.ULID.Scan(value)
+	if err != nil {
+		nu.Valid = false
+		return err
+	}
+
+	nu.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (nu NullULID) Value() (driver.Value, error) {
+	if !nu.Valid {
+		return nil, nil
+	}
+	// Delegate to ULID Value function
+	return nu.ULID.Value()
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (nu NullULID) MarshalBinary() ([]byte, error) {
+	if nu.Valid {
+		return nu.ULID.MarshalBinary()
+	}
+
+	return []byte(nil), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (nu *NullULID) UnmarshalBinary(data []byte) error {
+	if err := nu.ULID.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	nu.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (nu NullULID) MarshalText() ([]byte, error) {
+	if nu.Valid {
+		return nu.ULID.MarshalText()
+	}
+
+	return jsonNull, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (nu *NullULID) UnmarshalText(data []byte) error {
+	var id ULID
+	if err := id.UnmarshalText(data); err != nil {
+		nu.Valid = false
+		return err
+	}
+	nu.ULID = id
+	nu.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (nu NullULID) MarshalJSON() ([]byte, error) {
+	if nu.Valid {
+		return json.Marshal(nu.ULID)
+	}
+
+	return jsonNull, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (nu *NullULID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		*nu = NullULID{}
+		return nil // valid null ULID
+	}
+	err := json.Unmarshal(data, &nu.ULID)
+	nu.Valid = err == nil
+	return err
+}
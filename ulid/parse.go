@@ -36,26 +36,8 @@ var (
 		0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
 	}
 	parse = func(v []byte, strict bool, id *ULID) error {
-		// Check if a base32 encoded ULID is the right length.
-		if len(v) != EncodedSize {
-			return errors.ErrUlidDataSize
-		}
-
-		// Check if all the characters in a base32 encoded ULID are part of the
-		// expected base32 character set.
-		var isBase32 bool
-		for i := 0; i < 25; i++ {
-			isBase32 = dec[v[i]] == 0xFF
-		}
-		if strict && isBase32 {
-			return errors.ErrUlidInvalidCharacters
-		}
-
-		// Check if the first character in a base32 encoded ULID will overflow. This
-		// happens because the base32 representation encodes 130 bits, while the
-		// ULID is only 128 bits.
-		if v[0] > '7' {
-			return errors.ErrUlidOverflow
+		if err := validate(v, strict); err != nil {
+			return err
 		}
 
 		// 6 bytes timestamp (48 bits)
@@ -81,3 +63,58 @@ var (
 		return nil
 	}
 )
+
+// validate checks that v has the length of a base32 encoded ULID and does not
+// overflow 128 bits.  When strict is set it also checks that all characters of
+// v are part of the base32 character set.
+func validate[T string | []byte](v T, strict bool) error {
+	// Check if a base32 encoded ULID is the right length.
+	if len(v) != EncodedSize {
+		return errors.ErrUlidDataSize
+	}
+
+	// Check if all the characters in a base32 encoded ULID are part of the
+	// expected base32 character set.  Valid characters decode to at most 0x1F
+	// while invalid ones decode to 0xFF, so or-ing the decoded values sets the
+	// high bits if and only if any character is invalid.
+	if strict {
+		var acc byte
+		for i := 0; i < EncodedSize; i++ {
+			acc |= dec[v[i]]
+		}
+		if acc > 0x1F {
+			return invalidCharError(v)
+		}
+	}
+
+	// Check if the first character in a base32 encoded ULID will overflow. This
+	// happens because the base32 representation encodes 130 bits, while the
+	// ULID is only 128 bits.
+	if v[0] > '7' {
+		return errors.ParseError{
+			Kind:     errors.KindULID,
+			Offset:   0,
+			Byte:     v[0],
+			Expected: "'0'-'7'",
+			Err:      errors.ErrUlidOverflow,
+		}
+	}
+	return nil
+}
+
+// invalidCharError returns the ParseError for the first character of v that
+// is not part of the base32 alphabet.
+func invalidCharError[T string | []byte](v T) error {
+	for i := 0; i < len(v); i++ {
+		if c := v[i]; dec[c] == 0xFF {
+			return errors.ParseError{
+				Kind:     errors.KindULID,
+				Offset:   i,
+				Byte:     c,
+				Expected: "base32 character",
+				Err:      errors.ErrUlidInvalidCharacters,
+			}
+		}
+	}
+	return errors.ErrUlidInvalidCharacters
+}
@@ -0,0 +1,38 @@
+package ulid
+
+import (
+	e "errors"
+	"testing"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+func TestStrictInvalidCharacter(t *testing.T) {
+	const valid = "01AN4Z07BY79KA1307SR9X4MV3"
+	for _, off := range []int{0, 1, 12, 24, 25} {
+		s := valid[:off] + "!" + valid[off+1:]
+		for name, err := range map[string]error{
+			"ParseStrict": func() error { _, err := ParseStrict(s); return err }(),
+			"Validate":    Validate(s),
+		} {
+			var pe errors.ParseError
+			if !e.As(err, &pe) || !e.Is(err, errors.ErrUlidInvalidCharacters) {
+				t.Errorf("%s(%q) = %v, want a ParseError for ErrUlidInvalidCharacters", name, s, err)
+				continue
+			}
+			if pe.Offset != off || pe.Byte != '!' {
+				t.Errorf("%s(%q) reported %q at %d, want '!' at %d", name, s, pe.Byte, pe.Offset, off)
+			}
+		}
+	}
+	if err := Validate(valid); err != nil {
+		t.Errorf("Validate(%q) = %v", valid, err)
+	}
+}
+
+func TestValidateNoAllocs(t *testing.T) {
+	s := "01AN4Z07BY79KA1307SR9X4MV3"
+	if n := testing.AllocsPerRun(100, func() { _ = Validate(s) }); n != 0 {
+		t.Errorf("Validate allocates %v times, want 0", n)
+	}
+}
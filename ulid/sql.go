@@ -1,9 +1,11 @@
 package ulid
 
 import (
+	"database/sql"
 	"database/sql/driver"
 
 	"github.com/fajarnugraha37/goid/errors"
+	"github.com/fajarnugraha37/goid/uuid"
 )
 
 // Scan implements the sql.Scanner interface. It supports scanning a string or byte slice.
@@ -22,7 +24,115 @@ func (id *ULID) Scan(src interface{}) error {
 
 // Value implements the sql/driver.Valuer interface, returning the ULID as a
 // slice of bytes, by invoking MarshalBinary. If your use case requires a string
-// representation instead, you can create a wrapper type that calls String() instead.
-func (id *ULID) Value() (driver.Value, error) {
+// representation instead, use Text or UUIDText.
+func (id ULID) Value() (driver.Value, error) {
 	return id.MarshalBinary()
 }
+
+// Column adapters wrap a ULID to choose how it is stored in a database column. ULID itself is written as 16 bytes and
+// scanned from a string or 16 bytes. Pick the adapter matching the column type:
+//
+//	PostgreSQL uuid                  UUIDText
+//	MySQL CHAR(26), SQLite TEXT      Text
+//	MySQL BINARY(16), SQLite BLOB    Binary (or ULID)
+//	MySQL 8 UUID_TO_BIN(id, 1)       MySQLSwapped
+//	SQL Server uniqueidentifier      GUID
+//
+// The adapters storing the ULID in a UUID column use the 16 bytes of the ULID as the UUID, see ULID.UUID. Like ULID,
+// they leave the ULID unchanged when scanning NULL, use NullULID for nullable columns.
+
+// Text stores a ULID as its 26 character string form.
+type Text struct {
+	ULID ULID
+}
+
+// Scan implements the sql.Scanner interface. It supports scanning a string, or a byte slice holding either the string
+// form or the 16 bytes of a ULID.
+func (t *Text) Scan(src interface{}) error {
+	if b, ok := src.([]byte); ok && len(b) == EncodedSize {
+		return t.ULID.UnmarshalText(b)
+	}
+	return t.ULID.Scan(src)
+}
+
+// Value implements the sql/driver.Valuer interface, returning the string form of the ULID.
+func (t Text) Value() (driver.Value, error) {
+	return t.ULID.String(), nil
+}
+
+// Binary stores a ULID as its 16 bytes.
+type Binary struct {
+	ULID ULID
+}
+
+// Scan implements the sql.Scanner interface, see ULID.Scan.
+func (b *Binary) Scan(src interface{}) error {
+	return b.ULID.Scan(src)
+}
+
+// Value implements the sql/driver.Valuer interface, returning the 16 bytes of the ULID.
+func (b Binary) Value() (driver.Value, error) {
+	return b.ULID.MarshalBinary()
+}
+
+// UUIDText stores a ULID in the string form of a UUID, for UUID columns such as PostgreSQL's uuid type.
+type UUIDText struct {
+	ULID ULID
+}
+
+// Scan implements the sql.Scanner interface, see uuid.UUID.Scan.
+func (t *UUIDText) Scan(src interface{}) error {
+	var a uuid.Text
+	return scanUUID(&t.ULID, &a.UUID, &a, src)
+}
+
+// Value implements the sql/driver.Valuer interface, returning the ULID as a UUID string.
+func (t UUIDText) Value() (driver.Value, error) {
+	return uuid.Text{UUID: uuid.UUID(t.ULID)}.Value()
+}
+
+// MySQLSwapped stores a ULID in the byte order produced by MySQL's UUID_TO_BIN(id, 1), see uuid.MySQLSwapped. The
+// swap moves the low bits of the timestamp of a ULID to the end, so it is only useful for columns shared with UUIDs.
+type MySQLSwapped struct {
+	ULID ULID
+}
+
+// Scan implements the sql.Scanner interface, see uuid.MySQLSwapped.Scan.
+func (s *MySQLSwapped) Scan(src interface{}) error {
+	var a uuid.MySQLSwapped
+	return scanUUID(&s.ULID, &a.UUID, &a, src)
+}
+
+// Value implements the sql/driver.Valuer interface, returning the ULID in swapped byte order.
+func (s MySQLSwapped) Value() (driver.Value, error) {
+	return uuid.MySQLSwapped{UUID: uuid.UUID(s.ULID)}.Value()
+}
+
+// GUID stores a ULID in the mixed-endian byte order of SQL Server uniqueidentifier columns, see uuid.GUID.
+type GUID struct {
+	ULID ULID
+}
+
+// Scan implements the sql.Scanner interface, see uuid.GUID.Scan.
+func (g *GUID) Scan(src interface{}) error {
+	var a uuid.GUID
+	return scanUUID(&g.ULID, &a.UUID, &a, src)
+}
+
+// Value implements the sql/driver.Valuer interface, returning the ULID in GUID byte order.
+func (g GUID) Value() (driver.Value, error) {
+	return uuid.GUID{UUID: uuid.UUID(g.ULID)}.Value()
+}
+
+// scanUUID scans src into id through the uuid package adapter s, which stores its result in u. NULL leaves id
+// unchanged.
+func scanUUID(id *ULID, u *uuid.UUID, s sql.Scanner, src interface{}) error {
+	if src == nil {
+		return nil
+	}
+	if err := s.Scan(src); err != nil {
+		return err
+	}
+	*id = ULID(*u)
+	return nil
+}
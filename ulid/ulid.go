@@ -2,13 +2,16 @@ package ulid
 
 import (
 	"bytes"
+	"encoding/hex"
+	"fmt"
 
 	"github.com/fajarnugraha37/goid/errors"
+	"github.com/fajarnugraha37/goid/internal/crockford"
 )
 
 const (
 	// Encoding is the base 32 encoding alphabet used in ULID strings.
-	Encoding = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	Encoding = crockford.Alphabet
 	// A ULID consists of 26 characters, which includes:
 	// - A 48-bit timestamp (milliseconds since Unix epoch).
 	// - A 80-bit random component.
@@ -53,10 +56,54 @@ func (id *ULID) Bytes() []byte {
 
 // String returns a lexicographically sortable string encoded ULID (26 characters, non-standard base 32) e.g. 01AN4Z07BY79KA1307SR9X4MV3.
 // Format: tttttttttteeeeeeeeeeeeeeee where t is time and e is entropy.
-func (id *ULID) String() string {
-	ulid := make([]byte, EncodedSize)
-	_ = id.MarshalTextTo(ulid)
-	return string(ulid)
+func (id ULID) String() string {
+	var ulid [EncodedSize]byte
+	_ = id.MarshalTextTo(ulid[:])
+	return string(ulid[:])
+}
+
+// Format implements fmt.Formatter.  The supported verbs are:
+//
+//	%s, %v  canonical upper case string, e.g. 01AN4Z07BY79KA1307SR9X4MV3
+//	%l      lower case string, e.g. 01an4z07by79ka1307sr9x4mv3
+//	%q      quoted canonical string
+//	%x, %X  lower or upper case hex encoding of the 16 bytes
+//
+// Width and the '-' flag pad the output like they do for strings.
+func (id ULID) Format(f fmt.State, verb rune) {
+	var buf [2 * len(id)]byte
+	var out []byte
+	switch verb {
+	case 's', 'v', 'q':
+		out = buf[:EncodedSize]
+		_ = id.MarshalTextTo(out)
+	case 'l':
+		out = buf[:EncodedSize]
+		_ = id.MarshalTextTo(out)
+		for i, c := range out {
+			if 'A' <= c && c <= 'Z' {
+				out[i] = c + 'a' - 'A'
+			}
+		}
+	case 'x', 'X':
+		out = buf[:]
+		hex.Encode(out, id[:])
+		if verb == 'X' {
+			for i, c := range out {
+				if 'a' <= c && c <= 'f' {
+					out[i] = c - ('a' - 'A')
+				}
+			}
+		}
+	default:
+		fmt.Fprintf(f, "%%!%c(ulid.ULID=%s)", verb, id.String())
+		return
+	}
+
+	if verb != 'q' {
+		verb = 's'
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), out)
 }
 
 // IsZero returns true if the ULID is a zero-value ULID, i.e. ulid.Zero.
@@ -0,0 +1,40 @@
+package ulid
+
+import (
+	"github.com/fajarnugraha37/goid/errors"
+	"github.com/fajarnugraha37/goid/uuid"
+)
+
+// UUID returns the ULID as a UUID holding the same 16 bytes.  The conversion is
+// lossless, but the result generally isn't a valid RFC 9562 UUID since its
+// version and variant bits are taken from the entropy.  Use UUIDv7 for that.
+func (id *ULID) UUID() uuid.UUID {
+	return uuid.UUID(*id)
+}
+
+// UUIDv7 returns a Version 7 UUID with the millisecond timestamp of the ULID.
+// The first 74 bits of entropy are kept, the 6 bits at the position of the
+// UUID version and variant are overwritten, so the conversion is lossy.
+func (id *ULID) UUIDv7() uuid.UUID {
+	u := uuid.UUID(*id)
+	u.SetVersion(uuid.V7)
+	u.SetVariant(uuid.RFC4122)
+	return u
+}
+
+// FromUUID returns the ULID holding the same 16 bytes as u, it is the reverse
+// of ULID.UUID.
+func FromUUID(u uuid.UUID) *ULID {
+	id := ULID(u)
+	return &id
+}
+
+// FromUUIDv7 returns the ULID holding the same 16 bytes as the Version 7 UUID
+// u, so that its millisecond timestamp becomes the ULID time.
+// ErrUlidNotUUIDv7 is returned if u is not a Version 7 UUID.
+func FromUUIDv7(u uuid.UUID) (*ULID, error) {
+	if u.Version() != uuid.Version(uuid.V7) {
+		return &ULID{}, errors.ErrUlidNotUUIDv7
+	}
+	return FromUUID(u), nil
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/binary"
 	"fmt"
 	"os"
+
+	"github.com/fajarnugraha37/goid/errors"
 )
 
 // A Domain represents a Version 2 domain
@@ -16,11 +18,74 @@ const (
 	Org    = Domain(2)
 )
 
+// PosixUID and PosixGID hold the UID and GID of the process when the package
+// was initialized.
+//
+// Deprecated: the values are captured once at init.  Use PosixIDProvider, or
+// configure a LocalIDProvider with WithLocalIDProvider.
 var (
 	PosixUID = uint32(os.Getuid())
 	PosixGID = uint32(os.Getgid())
 )
 
+// A LocalIDProvider returns the local ID stored in DCE Security (Version 2)
+// UUIDs for a domain.
+type LocalIDProvider interface {
+	LocalID(domain Domain) (uint32, error)
+}
+
+// LocalIDFunc adapts a function to a LocalIDProvider.
+type LocalIDFunc func(domain Domain) (uint32, error)
+
+// LocalID implements LocalIDProvider.
+func (f LocalIDFunc) LocalID(domain Domain) (uint32, error) {
+	return f(domain)
+}
+
+// PosixIDProvider is the LocalIDProvider returning the current os.Getuid for
+// the Person domain and os.Getgid for the Group domain.  A NoLocalIDError is
+// returned for other domains and on systems without POSIX IDs.
+type PosixIDProvider struct{}
+
+// LocalID implements LocalIDProvider.
+func (PosixIDProvider) LocalID(domain Domain) (uint32, error) {
+	id := -1
+	switch domain {
+	case Person:
+		id = os.Getuid()
+	case Group:
+		id = os.Getgid()
+	}
+	if id < 0 {
+		return 0, errors.NoLocalIDError{Domain: byte(domain)}
+	}
+	return uint32(id), nil
+}
+
+// StaticIDProvider is a LocalIDProvider returning fixed local IDs per domain,
+// e.g. site defined IDs for the Org domain or IDs on non-POSIX systems.  A
+// NoLocalIDError is returned for domains missing from the map.
+type StaticIDProvider map[Domain]uint32
+
+// LocalID implements LocalIDProvider.
+func (p StaticIDProvider) LocalID(domain Domain) (uint32, error) {
+	id, ok := p[domain]
+	if !ok {
+		return 0, errors.NoLocalIDError{Domain: byte(domain)}
+	}
+	return id, nil
+}
+
+// WithLocalIDProvider sets the LocalIDProvider used by NewDCEPerson,
+// NewDCEGroup and NewDCELocal.  By default PosixIDProvider is used.
+func WithLocalIDProvider(p LocalIDProvider) Option {
+	return func(g *Generator) {
+		if p != nil {
+			g.localIDs = p
+		}
+	}
+}
+
 // NewDCESecurity returns a DCE Security (Version 2) UUID.
 //
 // The domain should be one of Person, Group or Org.
@@ -28,31 +93,140 @@ var (
 // domain and the users GID for the Group.  The meaning of id for
 // the domain Org or on non-POSIX systems is site defined.
 //
-// For a given domain/id pair the same token may be returned for up to 7 minutes and 10 seconds.
+// Only 64 UUIDs fit into a domain every 7 minutes and 10 seconds, further
+// UUIDs get timestamps in the future, see Generator.NewDCESecurity.
 func NewDCESecurity(domain Domain, id uint32) (UUID, error) {
-	uuid, err := newV1()
+	return defaultGenerator.NewDCESecurity(domain, id)
+}
+
+// NewDCESecurity returns a DCE Security (Version 2) UUID generated by g.
+//
+// As defined by DCE 1.1, the UUID is laid out like a Version 1 UUID except
+// that time_low holds id, clock_seq_low holds the domain and the remaining 6
+// bits of clock_seq_hi_and_reserved hold a sequence dedicated to the domain.
+// That sequence distinguishes UUIDs within the same truncated timestamp, which
+// only changes every 2^32 100s of nanoseconds (about 7 minutes).  Once all 64
+// sequence values of a domain were used within one timestamp, the timestamp
+// is advanced to the next one, ahead of the clock, rather than failing.
+func (g *Generator) NewDCESecurity(domain Domain, id uint32) (UUID, error) {
+	/*
+	    0                   1                   2                   3
+	    0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
+	   +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+	   |                           local_id                            |
+	   +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+	   |           time_mid            |  ver  |       time_high       |
+	   +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+	   |var|    seq    |    domain     |         node (0-1)            |
+	   +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+	   |                         node (2-5)                            |
+	   +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+	*/
+	g.timeMu.Lock()
+	now, _, err := g.getTime(Version(V2), nil)
+	var tick uint32
+	var seq byte
 	if err == nil {
-		uuid[6] = (uuid[6] & 0x0f) | 0x20 // Version 2
-		uuid[9] = byte(domain)
-		binary.BigEndian.PutUint32(uuid[0:], id)
+		tick, seq = g.v2Sequence(domain, uint32(now>>32))
+	}
+	g.timeMu.Unlock()
+	if err != nil {
+		return Nil, err
+	}
+	now = Time(tick) << 32 // time_low is replaced by id below
+
+	uuid := encodeV1(now, 0, g.node())
+	binary.BigEndian.PutUint32(uuid[0:], id)
+	uuid[6] = (uuid[6] & 0x0f) | 0x20 // Version 2
+	uuid[8] = 0x80 | seq              // Variant is 10
+	uuid[9] = byte(domain)
+	return uuid, nil
+}
+
+// v2Sequence is the state of the dedicated sequence of a domain.
+type v2Sequence struct {
+	tick  uint32 // truncated timestamp the sequence belongs to
+	seq   byte   // last sequence value
+	count byte   // number of values used within tick
+}
+
+// v2Sequence returns the truncated timestamp and the next 6 bit sequence
+// value of domain for the truncated timestamp tick.  The sequence starts at a
+// random value for every new tick.  Once all 64 values of a tick are used, the
+// next tick is borrowed, so the returned tick may be ahead of the clock.
+//
+// v2Sequence must be called with g.timeMu held.
+func (g *Generator) v2Sequence(domain Domain, tick uint32) (uint32, byte) {
+	if g.v2Seqs == nil {
+		g.v2Seqs = make(map[Domain]v2Sequence)
+	}
+	s, ok := g.v2Seqs[domain]
+	if ok && tick <= s.tick {
+		if s.count < 64 {
+			s.seq = (s.seq + 1) & 0x3f
+			s.count++
+			g.v2Seqs[domain] = s
+			return s.tick, s.seq
+		}
+		tick = s.tick + 1
 	}
-	return uuid, err
+	var b [1]byte
+	g.randomBits(b[:])
+	s = v2Sequence{tick: tick, seq: b[0] & 0x3f, count: 1}
+	g.v2Seqs[domain] = s
+	return s.tick, s.seq
 }
 
 // NewDCEPerson returns a DCE Security (Version 2) UUID in the person
-// domain with the id returned by os.Getuid.
+// domain with the local ID of the Person domain, by default the id
+// returned by os.Getuid.
 //
 //	NewDCESecurity(Person, uint32(os.Getuid()))
 func NewDCEPerson() (UUID, error) {
-	return NewDCESecurity(Person, uint32(os.Getuid()))
+	return defaultGenerator.NewDCELocal(Person)
 }
 
 // NewDCEGroup returns a DCE Security (Version 2) UUID in the group
-// domain with the id returned by os.Getgid.
+// domain with the local ID of the Group domain, by default the id
+// returned by os.Getgid.
 //
 //	NewDCESecurity(Group, uint32(os.Getgid()))
 func NewDCEGroup() (UUID, error) {
-	return NewDCESecurity(Group, uint32(os.Getgid()))
+	return defaultGenerator.NewDCELocal(Group)
+}
+
+// NewDCELocal returns a DCE Security (Version 2) UUID in domain with the local
+// ID returned by the LocalIDProvider of g.
+func (g *Generator) NewDCELocal(domain Domain) (UUID, error) {
+	id, err := g.localIDs.LocalID(domain)
+	if err != nil {
+		return Nil, err
+	}
+	return g.NewDCESecurity(domain, id)
+}
+
+// V2Fields holds the fields of a DCE Security (Version 2) UUID.
+type V2Fields struct {
+	Domain   Domain
+	ID       uint32  // local ID
+	Time     Time    // timestamp truncated to a multiple of 2^32
+	Sequence byte    // 6 bit sequence
+	Node     [6]byte // node ID
+}
+
+// V2Fields decodes the fields of the DCE Security (Version 2) UUID uuid.  An
+// InvalidVersionError is returned if uuid is not a Version 2 UUID.
+func (uuid UUID) V2Fields() (V2Fields, error) {
+	var f V2Fields
+	if uuid.Version() != Version(V2) {
+		return f, errors.InvalidVersionError{Version: byte(uuid.Version())}
+	}
+	f.Domain = uuid.Domain()
+	f.ID = uuid.ID()
+	f.Time = Time(int64(binary.BigEndian.Uint16(uuid[4:6]))<<32 | int64(binary.BigEndian.Uint16(uuid[6:8])&0xfff)<<48)
+	f.Sequence = uuid[8] & 0x3f
+	copy(f.Node[:], uuid[10:])
+	return f, nil
 }
 
 // Domain returns the domain for a Version 2 UUID.  Domains are only defined for Version 2 UUIDs.
@@ -0,0 +1,56 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fajarnugraha37/goid/clock"
+)
+
+func TestNewDCESecurityBeyondSequence(t *testing.T) {
+	g := NewGenerator(WithClock(clock.Fixed(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))))
+
+	seen := make(map[UUID]bool)
+	var lastTime Time
+	for i := 0; i < 200; i++ {
+		u, err := g.NewDCESecurity(Person, 1000)
+		if err != nil {
+			t.Fatalf("NewDCESecurity #%d: %v", i, err)
+		}
+		if seen[u] {
+			t.Fatalf("NewDCESecurity #%d: duplicate %s", i, u)
+		}
+		seen[u] = true
+
+		f, err := u.V2Fields()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.Domain != Person || f.ID != 1000 {
+			t.Fatalf("V2Fields = %+v", f)
+		}
+		if f.Time < lastTime {
+			t.Fatalf("NewDCESecurity #%d: time went backwards", i)
+		}
+		lastTime = f.Time
+	}
+}
+
+func TestNewV2Unique(t *testing.T) {
+	seen := make(map[UUID]bool)
+	for i := 0; i < 200; i++ {
+		u := NewV2(Group, 42)
+		if seen[u] {
+			t.Fatalf("NewV2 #%d: duplicate %s", i, u)
+		}
+		seen[u] = true
+
+		f, err := u.V2Fields()
+		if err != nil {
+			t.Fatalf("NewV2 #%d: %v", i, err)
+		}
+		if f.Domain != Group || f.ID != 42 {
+			t.Fatalf("NewV2 #%d: V2Fields = %+v", i, f)
+		}
+	}
+}
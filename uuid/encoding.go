@@ -0,0 +1,158 @@
+package uuid
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/fajarnugraha37/goid/errors"
+	"github.com/fajarnugraha37/goid/internal/crockford"
+)
+
+// Alphabets of the alternative text encodings.  All of them are in ascending
+// ASCII order, so the fixed width encodings sort like the UUID bytes.
+const (
+	Base32Alphabet = crockford.Alphabet // Crockford base32, as used by ULIDs
+	Base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	Base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// Lengths of the alternative text encodings of a UUID.  Shorter values are
+// padded with the zero digit of the alphabet.
+const (
+	Base32Size    = 26
+	Base58Size    = 22
+	Base62Size    = 22
+	Base64URLSize = 22
+)
+
+// A radix is a fixed width, big endian positional encoding of a UUID.
+type radix struct {
+	name     string
+	alphabet string
+	size     int
+	dec      [256]byte
+}
+
+func newRadix(name, alphabet string, size int, fold bool) *radix {
+	r := &radix{name: name, alphabet: alphabet, size: size}
+	for i := range r.dec {
+		r.dec[i] = 0xFF
+	}
+	for i := 0; i < len(alphabet); i++ {
+		c := alphabet[i]
+		r.dec[c] = byte(i)
+		if fold && 'A' <= c && c <= 'Z' {
+			r.dec[c+'a'-'A'] = byte(i)
+		}
+	}
+	return r
+}
+
+var (
+	base32 = newRadix("base32", Base32Alphabet, Base32Size, true)
+	base58 = newRadix("base58", Base58Alphabet, Base58Size, false)
+	base62 = newRadix("base62", Base62Alphabet, Base62Size, false)
+)
+
+// encode writes the encoding of uuid to dst, which must be r.size bytes long.
+func (r *radix) encode(dst []byte, uuid UUID) {
+	hi, lo := binary.BigEndian.Uint64(uuid[:8]), binary.BigEndian.Uint64(uuid[8:])
+	base := uint64(len(r.alphabet))
+	for i := r.size - 1; i >= 0; i-- {
+		var rem uint64
+		hi, rem = bits.Div64(0, hi, base)
+		lo, rem = bits.Div64(rem, lo, base)
+		dst[i] = r.alphabet[rem]
+	}
+}
+
+// decode parses the encoding of a UUID in s.
+func (r *radix) decode(s string) (UUID, error) {
+	if len(s) != r.size {
+		return Nil, errors.InvalidLengthError{Len: len(s)}
+	}
+	var hi, lo uint64
+	base := uint64(len(r.alphabet))
+	for i := 0; i < len(s); i++ {
+		d := r.dec[s[i]]
+		if d == 0xFF {
+			return Nil, errors.ParseError{
+				Kind:     errors.KindUUID,
+				Offset:   i,
+				Byte:     s[i],
+				Expected: r.name + " character",
+				Err:      errors.ErrInvalidUUIDFormat,
+			}
+		}
+		// (hi, lo) = (hi, lo) * base + d
+		carry, l := bits.Mul64(lo, base)
+		l, c := bits.Add64(l, uint64(d), 0)
+		over, h := bits.Mul64(hi, base)
+		h, c = bits.Add64(h, carry, c)
+		if over != 0 || c != 0 {
+			return Nil, errors.ErrEncodingOverflow
+		}
+		hi, lo = h, l
+	}
+
+	var uuid UUID
+	binary.BigEndian.PutUint64(uuid[:8], hi)
+	binary.BigEndian.PutUint64(uuid[8:], lo)
+	return uuid, nil
+}
+
+// Base32 returns the 26 character Crockford base32 encoding of uuid, the
+// encoding used for ULIDs.
+func (uuid UUID) Base32() string {
+	var buf [Base32Size]byte
+	base32.encode(buf[:], uuid)
+	return string(buf[:])
+}
+
+// Base58 returns the 22 character base58 encoding of uuid, using the Bitcoin
+// alphabet.
+func (uuid UUID) Base58() string {
+	var buf [Base58Size]byte
+	base58.encode(buf[:], uuid)
+	return string(buf[:])
+}
+
+// Base62 returns the 22 character base62 encoding of uuid.
+func (uuid UUID) Base62() string {
+	var buf [Base62Size]byte
+	base62.encode(buf[:], uuid)
+	return string(buf[:])
+}
+
+// Base64URL returns the 22 character unpadded base64url encoding of uuid (RFC
+// 4648 section 5).  The base64url alphabet is not in ASCII order, so unlike the
+// other encodings it does not sort like the UUID bytes.
+func (uuid UUID) Base64URL() string {
+	return base64.RawURLEncoding.EncodeToString(uuid[:])
+}
+
+// ParseBase32 decodes the Crockford base32 encoding of a UUID returned by
+// Base32.  Lower case letters are accepted.
+func ParseBase32(s string) (UUID, error) {
+	return base32.decode(s)
+}
+
+// ParseBase58 decodes the base58 encoding of a UUID returned by Base58.
+func ParseBase58(s string) (UUID, error) {
+	return base58.decode(s)
+}
+
+// ParseBase62 decodes the base62 encoding of a UUID returned by Base62.
+func ParseBase62(s string) (UUID, error) {
+	return base62.decode(s)
+}
+
+// ParseBase64URL decodes the unpadded base64url encoding of a UUID returned by
+// Base64URL.
+func ParseBase64URL(s string) (UUID, error) {
+	if len(s) != Base64URLSize {
+		return Nil, errors.InvalidLengthError{Len: len(s)}
+	}
+	return decodeBase64(base64.RawURLEncoding, s)
+}
@@ -0,0 +1,94 @@
+package uuid
+
+import (
+	e "errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+var encodings = []struct {
+	name   string
+	size   int
+	encode func(UUID) string
+	parse  func(string) (UUID, error)
+	sorts  bool
+}{
+	{"Base32", Base32Size, UUID.Base32, ParseBase32, true},
+	{"Base58", Base58Size, UUID.Base58, ParseBase58, true},
+	{"Base62", Base62Size, UUID.Base62, ParseBase62, true},
+	{"Base64URL", Base64URLSize, UUID.Base64URL, ParseBase64URL, false},
+}
+
+func TestEncodingRoundTrip(t *testing.T) {
+	uuids := []UUID{Nil, Max, MustParse("f81d4fae-7dec-11d0-a765-00a0c91e6bf6")}
+	for i := 0; i < 100; i++ {
+		uuids = append(uuids, NewV4())
+	}
+	for _, enc := range encodings {
+		for _, uuid := range uuids {
+			s := enc.encode(uuid)
+			if len(s) != enc.size {
+				t.Errorf("%s(%s) = %q, want %d characters", enc.name, uuid, s, enc.size)
+			}
+			got, err := enc.parse(s)
+			if err != nil || got != uuid {
+				t.Errorf("Parse%s(%q) = %s, %v, want %s", enc.name, s, got, err, uuid)
+			}
+		}
+	}
+	if got, err := ParseBase32(strings.ToLower(Max.Base32())); err != nil || got != Max {
+		t.Errorf("ParseBase32(lower case) = %s, %v, want %s", got, err, Max)
+	}
+}
+
+func TestEncodingOrder(t *testing.T) {
+	uuids := []UUID{Nil, Max}
+	for i := 0; i < 200; i++ {
+		uuids = append(uuids, NewV4())
+	}
+	sort.Slice(uuids, func(i, j int) bool { return Compare(uuids[i], uuids[j]) < 0 })
+	for _, enc := range encodings {
+		if !enc.sorts {
+			continue
+		}
+		for i := 1; i < len(uuids); i++ {
+			if a, b := enc.encode(uuids[i-1]), enc.encode(uuids[i]); a >= b {
+				t.Fatalf("%s: %q (%s) does not sort before %q (%s)", enc.name, a, uuids[i-1], b, uuids[i])
+			}
+		}
+	}
+}
+
+func TestEncodingOverflow(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		parse func(string) (UUID, error)
+		s     string
+	}{
+		{"Base32", ParseBase32, "8" + strings.Repeat("0", Base32Size-1)},
+		{"Base58", ParseBase58, strings.Repeat("z", Base58Size)},
+		{"Base62", ParseBase62, strings.Repeat("z", Base62Size)},
+	} {
+		if _, err := test.parse(test.s); err != errors.ErrEncodingOverflow {
+			t.Errorf("Parse%s(%q) = %v, want ErrEncodingOverflow", test.name, test.s, err)
+		}
+	}
+}
+
+func TestEncodingInvalidCharacter(t *testing.T) {
+	for _, enc := range encodings {
+		s := []byte(enc.encode(MustParse("f81d4fae-7dec-11d0-a765-00a0c91e6bf6")))
+		s[5] = '!'
+		_, err := enc.parse(string(s))
+		var pe errors.ParseError
+		if !e.As(err, &pe) || pe.Offset != 5 || pe.Byte != '!' {
+			t.Errorf("Parse%s(%q) = %v, want a ParseError at offset 5", enc.name, s, err)
+		}
+		if _, err := enc.parse(string(s[1:])); !e.Is(err, errors.ErrInvalidLength) {
+			t.Errorf("Parse%s(%q) = %v, want an InvalidLengthError", enc.name, s[1:], err)
+		}
+	}
+}
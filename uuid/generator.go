@@ -0,0 +1,183 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/fajarnugraha37/goid/clock"
+)
+
+// A Generator produces UUIDs from its own clock, random number generator,
+// node ID and clock sequence.  Generators do not share any state with each
+// other, so two libraries in the same binary can configure their own
+// Generator without affecting one another.
+//
+// The package level functions (NewV1, NewV4, SetRand, SetNodeID, ...) operate
+// on a default Generator.
+//
+// A Generator is safe for concurrent use, with the exception of SetRand,
+// SetClock, EnableRandPool and DisableRandPool which should only be called
+// when no UUIDs are being generated concurrently.
+type Generator struct {
+	now func() time.Time
+
+	timeMu     sync.Mutex
+	lasttime   uint64 // last time we returned
+	clockSeq   uint16 // clock sequence for this run
+	lastV7time int64  // last v7 time we returned, see getV7Time
+	lastV7read int64  // last v7 time read from the clock, in the same format
+
+	regressionPolicy  RegressionPolicy
+	regressionHook    RegressionHook
+	regressionMaxWait time.Duration // longest RegressionWait blocks
+
+	store         StateStore
+	storeInterval uint64 // checkpoint interval in 100s of nanoseconds
+	storeLoaded   bool   // state has been loaded from store
+	storeSaved    uint64 // timestamp of the last checkpoint
+	storeLast     uint64 // timestamp loaded from store, until getTime used it
+
+	v7Method      V7Method
+	v7CounterBits int    // width of the V7DedicatedCounter counter
+	v7Inc         uint64 // maximum V7MonotonicRandom increment
+	v7ms          int64  // millisecond of v7Counter and v7Rand
+	v7Counter     uint64 // last V7DedicatedCounter counter
+	v7Rand        v7Rand // last V7MonotonicRandom rand_a and rand_b
+
+	nodeMu sync.Mutex
+	ifname string  // name of interface being used
+	nodeID [6]byte // hardware for version 1 UUIDs
+
+	localIDs LocalIDProvider
+	v2Seqs   map[Domain]v2Sequence // protected with timeMu
+
+	nsMu      sync.Mutex
+	namespace UUID // namespace for Version 3 and 5 UUIDs
+
+	rander      io.Reader
+	poolEnabled bool
+	poolMu      sync.Mutex
+	poolPos     int                // protected with poolMu
+	pool        [randPoolSize]byte // protected with poolMu
+}
+
+// An Option configures a Generator created by NewGenerator.
+type Option func(*Generator)
+
+// WithClock sets the Clock used by the Generator to read the current time.
+// By default the wall clock is used.
+func WithClock(c clock.Clock) Option {
+	return func(g *Generator) {
+		g.SetClock(c)
+	}
+}
+
+// WithTimeFunc sets the function used by the Generator to read the current
+// time.  It is the same as WithClock(clock.Func(now)).
+func WithTimeFunc(now func() time.Time) Option {
+	return func(g *Generator) {
+		if now != nil {
+			g.SetClock(clock.Func(now))
+		}
+	}
+}
+
+// WithRand sets the random number generator used by the Generator.  By
+// default crypto/rand.Reader is used.
+func WithRand(r io.Reader) Option {
+	return func(g *Generator) {
+		g.SetRand(r)
+	}
+}
+
+// WithNodeID sets the Node ID used for Version 1, 2 and 6 UUIDs.  The first 6
+// bytes of id are used.  If id is less than 6 bytes the option is ignored and
+// the Node ID is derived from the hardware interfaces on first use.
+func WithNodeID(id []byte) Option {
+	return func(g *Generator) {
+		g.SetNodeID(id)
+	}
+}
+
+// WithNodeInterface selects the hardware address used as the Node ID, see
+// SetNodeInterface.
+func WithNodeInterface(name string) Option {
+	return func(g *Generator) {
+		g.SetNodeInterface(name)
+	}
+}
+
+// WithClockSequence sets the clock sequence to the lower 14 bits of seq.
+func WithClockSequence(seq int) Option {
+	return func(g *Generator) {
+		g.SetClockSequence(seq)
+	}
+}
+
+// WithNamespace sets the namespace used for Version 3 and 5 UUIDs.  By default
+// the Nil UUID is used.
+func WithNamespace(ns UUID) Option {
+	return func(g *Generator) {
+		g.SetNamespace(ns)
+	}
+}
+
+// WithRandPool enables the randomness pool used for Version 4 and Version 7
+// UUID generation, see EnableRandPool.
+func WithRandPool() Option {
+	return func(g *Generator) {
+		g.EnableRandPool()
+	}
+}
+
+// NewGenerator returns a Generator configured with opts.
+func NewGenerator(opts ...Option) *Generator {
+	g := &Generator{
+		now:     time.Now,
+		rander:  rand.Reader,
+		poolPos: randPoolSize,
+
+		localIDs: PosixIDProvider{},
+
+		v7CounterBits: v7MinCounterBits,
+		v7Inc:         math.MaxUint32,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+var defaultGenerator = NewGenerator()
+
+// DefaultGenerator returns the Generator used by the package level functions.
+func DefaultGenerator() *Generator {
+	return defaultGenerator
+}
+
+// SetClock sets the Clock used by the package level functions to read the
+// current time.  Passing nil restores the wall clock.
+//
+// SetClock is not thread-safe and should only be called when no UUIDs are
+// being generated concurrently.
+func SetClock(c clock.Clock) {
+	defaultGenerator.SetClock(c)
+}
+
+// SetClock sets the Clock of g, see SetClock.
+func (g *Generator) SetClock(c clock.Clock) {
+	if c == nil {
+		c = clock.Wall{}
+	}
+	g.now = c.Now
+}
+
+// randomBits completely fills slice b with random data.
+func (g *Generator) randomBits(b []byte) {
+	if _, err := io.ReadFull(g.rander, b); err != nil {
+		panic(err.Error()) // rand should never fail
+	}
+}
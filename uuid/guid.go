@@ -0,0 +1,89 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// guidSwap converts between RFC 9562 and Microsoft GUID byte order by
+// reversing the first three fields (Data1, Data2 and Data3), which GUIDs
+// store little-endian.  It is its own inverse.
+func guidSwap(dst, src []byte) {
+	dst[0], dst[1], dst[2], dst[3] = src[3], src[2], src[1], src[0]
+	dst[4], dst[5] = src[5], src[4]
+	dst[6], dst[7] = src[7], src[6]
+	copy(dst[8:16], src[8:16])
+}
+
+// GUIDBytes returns uuid in the mixed-endian byte order of Windows GUIDs and
+// SQL Server uniqueidentifier values.  The byte order is unrelated to the
+// Microsoft variant: GUIDs of any variant are stored this way.
+func (uuid UUID) GUIDBytes() []byte {
+	b := make([]byte, Size)
+	guidSwap(b, uuid[:])
+	return b
+}
+
+// FromGUIDBytes creates a new UUID from the 16 bytes of a GUID in mixed-endian
+// byte order, as returned by GUIDBytes.  Returns an error if the slice does
+// not have a length of 16.
+func FromGUIDBytes(b []byte) (uuid UUID, err error) {
+	if len(b) != Size {
+		return uuid, fmt.Errorf("invalid GUID (got %d bytes)", len(b))
+	}
+	guidSwap(uuid[:], b)
+	return uuid, nil
+}
+
+// GUID wraps a UUID to read and write it from databases in GUID byte order,
+// e.g. SQL Server uniqueidentifier columns.  Its text form is the one of UUID.
+type GUID struct {
+	UUID UUID
+}
+
+// String returns the string form of the UUID, see UUID.String.
+func (g GUID) String() string {
+	return g.UUID.String()
+}
+
+// Scan implements sql.Scanner.  16 byte values are read in GUID byte order,
+// other values are scanned like a UUID.
+func (g *GUID) Scan(src interface{}) error {
+	if b, ok := src.([]byte); ok && len(b) == Size {
+		guidSwap(g.UUID[:], b)
+		return nil
+	}
+	return g.UUID.Scan(src)
+}
+
+// Value implements sql.Valuer, returning the UUID in GUID byte order.
+func (g GUID) Value() (driver.Value, error) {
+	return g.UUID.GUIDBytes(), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (g GUID) MarshalText() ([]byte, error) {
+	return g.UUID.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (g *GUID) UnmarshalText(data []byte) error {
+	return g.UUID.UnmarshalText(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, returning the UUID in
+// GUID byte order like .NET's Guid.ToByteArray.
+func (g GUID) MarshalBinary() ([]byte, error) {
+	return g.UUID.GUIDBytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, reading 16 bytes in
+// GUID byte order.
+func (g *GUID) UnmarshalBinary(data []byte) error {
+	u, err := FromGUIDBytes(data)
+	if err != nil {
+		return err
+	}
+	g.UUID = u
+	return nil
+}
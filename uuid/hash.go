@@ -3,6 +3,8 @@ package uuid
 import (
 	"crypto/md5"
 	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
 	"hash"
 )
 
@@ -14,6 +16,12 @@ var (
 	NameSpaceX500 = MustParse("6ba7b814-9dad-11d1-80b4-00c04fd430c8")
 	Nil           UUID // empty UUID, all zeros
 
+	// Hash space IDs registered in RFC 9562 (section 6.6) for name-based
+	// Version 8 UUIDs.  They identify the hash algorithm used to compute a
+	// name-based UUID and can be used as namespaces of their own.
+	HashSpaceSHA256 = MustParse("3fb32780-953c-4464-9cfd-e85dbbe9843d")
+	HashSpaceSHA512 = MustParse("0fde22f2-e7ba-4fd1-9753-9c2ea88fa3f5")
+
 	// The Max UUID is special form of UUID that is specified to have all 128 bits set to 1.
 	Max = UUID{
 		0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
@@ -25,7 +33,7 @@ var (
 // data generated by h.  The hash should be at least 16 byte in length.  The
 // first 16 bytes of the hash are used to form the UUID.  The version of the
 // UUID will be the lower 4 bits of version.  NewHash is used to implement
-// NewMD5 and NewSHA1.
+// NewMD5, NewSHA1, NewSHA256 and NewSHA512.
 func NewHash(h hash.Hash, space UUID, data []byte, version int) UUID {
 	h.Reset()
 	h.Write(space[:]) //nolint:errcheck
@@ -53,3 +61,20 @@ func NewMD5(space UUID, data []byte) UUID {
 func NewSHA1(space UUID, data []byte) UUID {
 	return NewHash(sha1.New(), space, data, 5)
 }
+
+// NewSHA256 returns a new name-based SHA-256 (Version 8) UUID based on the
+// supplied name space and data, as in RFC 9562 Appendix B.2.  It is the same
+// as calling:
+//
+//	NewHash(sha256.New(), space, data, 8)
+func NewSHA256(space UUID, data []byte) UUID {
+	return NewHash(sha256.New(), space, data, 8)
+}
+
+// NewSHA512 returns a new name-based SHA-512 (Version 8) UUID based on the
+// supplied name space and data.  It is the same as calling:
+//
+//	NewHash(sha512.New(), space, data, 8)
+func NewSHA512(space UUID, data []byte) UUID {
+	return NewHash(sha512.New(), space, data, 8)
+}
@@ -3,7 +3,6 @@ package uuid
 import (
 	"bytes"
 	"encoding/hex"
-	"io"
 	"strings"
 
 	"github.com/fajarnugraha37/goid/errors"
@@ -32,11 +31,15 @@ func encodeHex(dst []byte, uuid UUID) {
 // urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx) are decoded.  In addition,
 // Parse accepts non-standard strings such as the raw hex encoding
 // xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx and 38 byte "Microsoft style" encodings,
-// e.g.  {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}.  Only the middle 36 bytes are
-// examined in the latter case.  Parse should not be used to validate strings as
-// it parses non-standard encodings as indicated above.
+// e.g.  {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}.  Parse should not be used to
+// validate strings as it parses non-standard encodings as indicated above, see
+// ParseWithOptions for a strict mode.
+//
+// Invalid characters are reported as an errors.ParseError holding their offset
+// in s, which matches errors.ErrInvalidUUIDFormat.
 func Parse(s string) (UUID, error) {
 	var uuid UUID
+	off := 0
 	switch len(s) {
 	// xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
 	case 36:
@@ -46,11 +49,14 @@ func Parse(s string) (UUID, error) {
 		if !strings.EqualFold(s[:9], "urn:uuid:") {
 			return uuid, errors.URNPrefixError{Prefix: s[:9]}
 		}
-		s = s[9:]
+		s, off = s[9:], 9
 
 	// {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}
 	case 36 + 2:
-		s = s[1:]
+		if s[0] != '{' || s[len(s)-1] != '}' {
+			return uuid, errors.ErrInvalidBracketedFormat
+		}
+		s, off = s[1:], 1
 
 	// xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
 	case 32:
@@ -58,7 +64,7 @@ func Parse(s string) (UUID, error) {
 		for i := range uuid {
 			uuid[i], ok = xtob(s[i*2], s[i*2+1])
 			if !ok {
-				return uuid, errors.ErrInvalidUUIDFormat
+				return uuid, hexError(s[i*2], s[i*2+1], i*2)
 			}
 		}
 		return uuid, nil
@@ -67,9 +73,10 @@ func Parse(s string) (UUID, error) {
 	}
 	// s is now at least 36 bytes long
 	// it must be of the form  xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
-	if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
-		return uuid, errors.ErrInvalidUUIDFormat
-
+	for _, x := range [4]int{8, 13, 18, 23} {
+		if s[x] != '-' {
+			return uuid, hyphenError(s[x], off+x)
+		}
 	}
 	for i, x := range [16]int{
 		0, 2, 4, 6,
@@ -80,7 +87,7 @@ func Parse(s string) (UUID, error) {
 	} {
 		v, ok := xtob(s[x], s[x+1])
 		if !ok {
-			return uuid, errors.ErrInvalidUUIDFormat
+			return uuid, hexError(s[x], s[x+1], off+x)
 		}
 		uuid[i] = v
 	}
@@ -90,21 +97,25 @@ func Parse(s string) (UUID, error) {
 // ParseBytes is like Parse, except it parses a byte slice instead of a string.
 func ParseBytes(b []byte) (UUID, error) {
 	var uuid UUID
+	off := 0
 	switch len(b) {
 	case 36: // xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
 	case 36 + 9: // urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
 		if !bytes.EqualFold(b[:9], []byte("urn:uuid:")) {
 			return uuid, errors.URNPrefixError{Prefix: string(b[:9])}
 		}
-		b = b[9:]
+		b, off = b[9:], 9
 	case 36 + 2: // {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}
-		b = b[1:]
+		if b[0] != '{' || b[len(b)-1] != '}' {
+			return uuid, errors.ErrInvalidBracketedFormat
+		}
+		b, off = b[1:], 1
 	case 32: // xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
 		var ok bool
 		for i := 0; i < 32; i += 2 {
 			uuid[i/2], ok = xtob(b[i], b[i+1])
 			if !ok {
-				return uuid, errors.ErrInvalidUUIDFormat
+				return uuid, hexError(b[i], b[i+1], i)
 			}
 		}
 		return uuid, nil
@@ -113,8 +124,10 @@ func ParseBytes(b []byte) (UUID, error) {
 	}
 	// s is now at least 36 bytes long
 	// it must be of the form  xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
-	if b[8] != '-' || b[13] != '-' || b[18] != '-' || b[23] != '-' {
-		return uuid, errors.ErrInvalidUUIDFormat
+	for _, x := range [4]int{8, 13, 18, 23} {
+		if b[x] != '-' {
+			return uuid, hyphenError(b[x], off+x)
+		}
 	}
 	for i, x := range [16]int{
 		0, 2, 4, 6,
@@ -125,20 +138,13 @@ func ParseBytes(b []byte) (UUID, error) {
 	} {
 		v, ok := xtob(b[x], b[x+1])
 		if !ok {
-			return uuid, errors.ErrInvalidUUIDFormat
+			return uuid, hexError(b[x], b[x+1], off+x)
 		}
 		uuid[i] = v
 	}
 	return uuid, nil
 }
 
-// randomBits completely fills slice b with random data.
-func randomBits(b []byte) {
-	if _, err := io.ReadFull(rander, b); err != nil {
-		panic(err.Error()) // rand should never fail
-	}
-}
-
 // xvalues returns the value of a byte as a hexadecimal digit or 255.
 var xvalues = [256]byte{
 	255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
@@ -166,6 +172,33 @@ func xtob(x1, x2 byte) (byte, bool) {
 	return (b1 << 4) | b2, b1 != 255 && b2 != 255
 }
 
+// hexError returns the ParseError for the hex pair x1, x2 at offset off that
+// xtob rejected.
+func hexError(x1, x2 byte, off int) error {
+	if xvalues[x1] != 255 {
+		x1, off = x2, off+1
+	}
+	return errors.ParseError{
+		Kind:     errors.KindUUID,
+		Offset:   off,
+		Byte:     x1,
+		Expected: "hex digit",
+		Err:      errors.ErrInvalidUUIDFormat,
+	}
+}
+
+// hyphenError returns the ParseError for the byte c at offset off where a
+// hyphen was expected.
+func hyphenError(c byte, off int) error {
+	return errors.ParseError{
+		Kind:     errors.KindUUID,
+		Offset:   off,
+		Byte:     c,
+		Expected: "'-'",
+		Err:      errors.ErrInvalidUUIDFormat,
+	}
+}
+
 // Compare returns an integer comparing two uuids lexicographically. The result will be 0 if a == b, -1 if a < b, and +1 if a > b.
 func Compare(a, b UUID) int {
 	return bytes.Compare(a[:], b[:])
@@ -205,6 +238,7 @@ func Must(uuid UUID, err error) UUID {
 //
 // It returns an error if the format is invalid, otherwise nil.
 func Validate(s string) error {
+	off := 0
 	switch len(s) {
 	// Standard UUID format
 	case 36:
@@ -214,21 +248,21 @@ func Validate(s string) error {
 		if !strings.EqualFold(s[:9], "urn:uuid:") {
 			return errors.URNPrefixError{Prefix: s[:9]}
 		}
-		s = s[9:]
+		s, off = s[9:], 9
 
 	// UUID enclosed in braces
 	case 36 + 2:
 		if s[0] != '{' || s[len(s)-1] != '}' {
 			return errors.ErrInvalidBracketedFormat
 		}
-		s = s[1 : len(s)-1]
+		s, off = s[1:len(s)-1], 1
 
 	// UUID without hyphens
 	case 32:
 		for i := 0; i < len(s); i += 2 {
 			_, ok := xtob(s[i], s[i+1])
 			if !ok {
-				return errors.ErrInvalidUUIDFormat
+				return hexError(s[i], s[i+1], i)
 			}
 		}
 
@@ -238,12 +272,14 @@ func Validate(s string) error {
 
 	// Check for standard UUID format
 	if len(s) == 36 {
-		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
-			return errors.ErrInvalidUUIDFormat
+		for _, x := range [4]int{8, 13, 18, 23} {
+			if s[x] != '-' {
+				return hyphenError(s[x], off+x)
+			}
 		}
 		for _, x := range []int{0, 2, 4, 6, 9, 11, 14, 16, 19, 21, 24, 26, 28, 30, 32, 34} {
 			if _, ok := xtob(s[x], s[x+1]); !ok {
-				return errors.ErrInvalidUUIDFormat
+				return hexError(s[x], s[x+1], off+x)
 			}
 		}
 	}
@@ -0,0 +1,183 @@
+package uuid
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+// A JSONFormat is the representation of a UUID in JSON.
+type JSONFormat int32
+
+// JSON representations of a UUID.
+const (
+	JSONCanonical JSONFormat = iota // "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+	JSONCompact                     // "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+	JSONURN                         // "urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+	JSONBase64                      // padded standard base64, like encoding/json encodes []byte
+	JSONBase62                      // 22 characters, see UUID.Base62
+)
+
+func (f JSONFormat) String() string {
+	switch f {
+	case JSONCanonical:
+		return "canonical"
+	case JSONCompact:
+		return "compact"
+	case JSONURN:
+		return "urn"
+	case JSONBase64:
+		return "base64"
+	case JSONBase62:
+		return "base62"
+	}
+	return fmt.Sprintf("JSONFormat%d", int(f))
+}
+
+// A JSONFormatter names a JSONFormat at the type level, so that Formatted
+// values know their format even when unmarshaled into a zero value.
+type JSONFormatter interface {
+	JSONFormat() JSONFormat
+}
+
+// Type arguments of Formatted and NullFormatted, one per JSONFormat.
+type (
+	CanonicalJSON struct{}
+	CompactJSON   struct{}
+	URNJSON       struct{}
+	Base64JSON    struct{}
+	Base62JSON    struct{}
+)
+
+func (CanonicalJSON) JSONFormat() JSONFormat { return JSONCanonical }
+func (CompactJSON) JSONFormat() JSONFormat   { return JSONCompact }
+func (URNJSON) JSONFormat() JSONFormat       { return JSONURN }
+func (Base64JSON) JSONFormat() JSONFormat    { return JSONBase64 }
+func (Base62JSON) JSONFormat() JSONFormat    { return JSONBase62 }
+
+// AppendJSON appends the JSON string of uuid in format f to b.
+func (f JSONFormat) AppendJSON(b []byte, uuid UUID) []byte {
+	b = append(b, '"')
+	switch f {
+	case JSONCompact:
+		b = hex.AppendEncode(b, uuid[:])
+	case JSONURN:
+		b = append(b, "urn:uuid:"...)
+		b, _ = uuid.AppendText(b)
+	case JSONBase64:
+		b = base64.StdEncoding.AppendEncode(b, uuid[:])
+	case JSONBase62:
+		var buf [Base62Size]byte
+		base62.encode(buf[:], uuid)
+		b = append(b, buf[:]...)
+	default:
+		b, _ = uuid.AppendText(b)
+	}
+	return append(b, '"')
+}
+
+// ParseJSON decodes the JSON string data into a UUID.  Every format accepted
+// by ParseWithOptions in lenient mode is accepted, whatever f is, except for
+// 22 character strings: base62 and unpadded base64 cannot be told apart, so
+// they are read as base62 if f is JSONBase62, as base64 if f is JSONBase64 and
+// rejected otherwise.
+func (f JSONFormat) ParseJSON(data []byte) (UUID, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return Nil, err
+	}
+	if t := strings.TrimSpace(s); len(t) == Base62Size {
+		switch f {
+		case JSONBase62:
+			return ParseBase62(t)
+		case JSONBase64:
+			return parseBase64(t)
+		}
+		return Nil, errors.InvalidLengthError{Len: len(t)}
+	}
+	uuid, _, err := ParseWithOptions(s, ParseOptions{Mode: ParseModeLenient})
+	return uuid, err
+}
+
+// MarshalJSON implements json.Marshaler.  UUIDs are always marshaled in the
+// canonical form, use Formatted for other formats.
+func (uuid UUID) MarshalJSON() ([]byte, error) {
+	return JSONCanonical.AppendJSON(make([]byte, 0, 38), uuid), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, see JSONFormat.ParseJSON.  A JSON
+// null leaves uuid unchanged.
+func (uuid *UUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	id, err := JSONCanonical.ParseJSON(data)
+	if err != nil {
+		return err
+	}
+	*uuid = id
+	return nil
+}
+
+// Formatted is a UUID marshaled to JSON in the format named by F, e.g. for
+// fields of a partner API requiring compact UUIDs:
+//
+//	type Order struct {
+//		ID uuid.Formatted[uuid.CompactJSON] `json:"id"`
+//	}
+//
+//	Order{ID: uuid.Formatted[uuid.CompactJSON]{UUID: id}}
+type Formatted[F JSONFormatter] struct {
+	UUID UUID
+}
+
+// MarshalJSON implements json.Marshaler.
+func (fu Formatted[F]) MarshalJSON() ([]byte, error) {
+	var f F
+	return f.JSONFormat().AppendJSON(make([]byte, 0, 48), fu.UUID), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.  A JSON null leaves fu
+// unchanged.
+func (fu *Formatted[F]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var f F
+	id, err := f.JSONFormat().ParseJSON(data)
+	if err != nil {
+		return err
+	}
+	fu.UUID = id
+	return nil
+}
+
+// NullFormatted is a NullUUID marshaled to JSON in the format named by F.
+type NullFormatted[F JSONFormatter] struct {
+	UUID  UUID
+	Valid bool // Valid is true if UUID is not NULL
+}
+
+// MarshalJSON implements json.Marshaler.
+func (nu NullFormatted[F]) MarshalJSON() ([]byte, error) {
+	if !nu.Valid {
+		return jsonNull, nil
+	}
+	return Formatted[F]{UUID: nu.UUID}.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (nu *NullFormatted[F]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*nu = NullFormatted[F]{}
+		return nil
+	}
+	var fu Formatted[F]
+	err := fu.UnmarshalJSON(data)
+	nu.UUID, nu.Valid = fu.UUID, err == nil
+	return err
+}
@@ -0,0 +1,73 @@
+package uuid
+
+import (
+	"encoding/json"
+	e "errors"
+	"testing"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+func TestParseJSONShortForms(t *testing.T) {
+	want := Must(Parse("f81d4fae-7dec-11d0-a765-00a0c91e6bf6"))
+	for _, f := range []JSONFormat{JSONBase62, JSONBase64} {
+		data := f.AppendJSON(nil, want)
+		got, err := f.ParseJSON(data)
+		if err != nil || got != want {
+			t.Errorf("%v.ParseJSON(%s) = %v, %v, want %v", f, data, got, err, want)
+		}
+	}
+	for _, f := range []JSONFormat{JSONCanonical, JSONCompact, JSONURN} {
+		if _, err := f.ParseJSON(JSONBase62.AppendJSON(nil, want)); !e.Is(err, errors.ErrInvalidLength) {
+			t.Errorf("%v.ParseJSON(base62) = %v, want an InvalidLengthError", f, err)
+		}
+	}
+	if got, err := JSONBase64.ParseJSON([]byte(`"+B1Prn3sEdCnZQCgyR5r9g"`)); err != nil || got != want {
+		t.Errorf("JSONBase64.ParseJSON(unpadded) = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestFormattedJSON(t *testing.T) {
+	type order struct {
+		ID   Formatted[Base62JSON]      `json:"id"`
+		Ref  NullFormatted[CompactJSON] `json:"ref"`
+		Plan UUID                       `json:"plan"`
+	}
+	id := Must(Parse("f81d4fae-7dec-11d0-a765-00a0c91e6bf6"))
+	in := order{ID: Formatted[Base62JSON]{UUID: id}, Ref: NullFormatted[CompactJSON]{UUID: id, Valid: true}, Plan: id}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"id":"` + id.Base62() + `","ref":"f81d4fae7dec11d0a76500a0c91e6bf6","plan":"f81d4fae-7dec-11d0-a765-00a0c91e6bf6"}`
+	if string(data) != want {
+		t.Fatalf("Marshal = %s, want %s", data, want)
+	}
+	var out order
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out != in {
+		t.Errorf("Unmarshal = %+v, want %+v", out, in)
+	}
+}
+
+func TestUnmarshalJSONMalformed(t *testing.T) {
+	data := []byte(`"AAAAAAAAAAAAAAAAAAAAAAA="`)
+	var uuid UUID
+	if err := json.Unmarshal(data, &uuid); err == nil {
+		t.Errorf("Unmarshal into UUID = %v, want an error", uuid)
+	}
+	var nu NullUUID
+	if err := json.Unmarshal(data, &nu); err == nil || nu.Valid {
+		t.Errorf("Unmarshal into NullUUID = %+v, %v, want an error", nu, err)
+	}
+	var fu Formatted[Base64JSON]
+	if err := json.Unmarshal(data, &fu); err == nil {
+		t.Errorf("Unmarshal into Formatted = %v, want an error", fu.UUID)
+	}
+	var nf NullFormatted[Base64JSON]
+	if err := json.Unmarshal(data, &nf); err == nil || nf.Valid {
+		t.Errorf("Unmarshal into NullFormatted = %+v, %v, want an error", nf, err)
+	}
+}
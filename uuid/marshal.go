@@ -1,6 +1,11 @@
 package uuid
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
 
 // MarshalText implements encoding.TextMarshaler.
 func (uuid UUID) MarshalText() ([]byte, error) {
@@ -19,11 +24,38 @@ func (uuid *UUID) UnmarshalText(data []byte) error {
 	return nil
 }
 
+// AppendText implements encoding.TextAppender (Go 1.24) by appending the
+// string form of uuid to b.  It does not allocate if b has room for 36 more
+// bytes.
+func (uuid UUID) AppendText(b []byte) ([]byte, error) {
+	b = slices.Grow(b, 36)
+	n := len(b)
+	b = b[:n+36]
+	encodeHex(b[n:], uuid)
+	return b, nil
+}
+
+// EncodeTo writes the string form of uuid to the first 36 bytes of dst.
+// errors.ErrBufferSize is returned if dst is shorter.
+func (uuid UUID) EncodeTo(dst []byte) error {
+	if len(dst) < 36 {
+		return errors.ErrBufferSize
+	}
+	encodeHex(dst, uuid)
+	return nil
+}
+
 // MarshalBinary implements encoding.BinaryMarshaler.
 func (uuid UUID) MarshalBinary() ([]byte, error) {
 	return uuid[:], nil
 }
 
+// AppendBinary implements encoding.BinaryAppender (Go 1.24) by appending the
+// 16 bytes of uuid to b.
+func (uuid UUID) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, uuid[:]...), nil
+}
+
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
 func (uuid *UUID) UnmarshalBinary(data []byte) error {
 	if len(data) != 16 {
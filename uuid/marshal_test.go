@@ -0,0 +1,47 @@
+package uuid
+
+import "testing"
+
+func TestAppendNoAllocs(t *testing.T) {
+	uuid := Must(Parse("f81d4fae-7dec-11d0-a765-00a0c91e6bf6"))
+	buf := make([]byte, 0, 64)
+	for _, test := range []struct {
+		name string
+		f    func()
+	}{
+		{"AppendText", func() { _, _ = uuid.AppendText(buf) }},
+		{"AppendBinary", func() { _, _ = uuid.AppendBinary(buf) }},
+		{"EncodeTo", func() { _ = uuid.EncodeTo(buf[:36]) }},
+	} {
+		if n := testing.AllocsPerRun(100, test.f); n != 0 {
+			t.Errorf("%s allocates %v times, want 0", test.name, n)
+		}
+	}
+}
+
+func BenchmarkAppendText(b *testing.B) {
+	uuid := NewV4()
+	buf := make([]byte, 0, 36)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, _ = uuid.AppendText(buf[:0])
+	}
+}
+
+func BenchmarkAppendBinary(b *testing.B) {
+	uuid := NewV4()
+	buf := make([]byte, 0, Size)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, _ = uuid.AppendBinary(buf[:0])
+	}
+}
+
+func BenchmarkEncodeTo(b *testing.B) {
+	uuid := NewV4()
+	var buf [36]byte
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = uuid.EncodeTo(buf[:])
+	}
+}
@@ -0,0 +1,76 @@
+package uuid
+
+import (
+	"sync"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+var (
+	namespaceMu sync.RWMutex
+	namespaces  = map[string]UUID{
+		"dns":  NameSpaceDNS,
+		"url":  NameSpaceURL,
+		"oid":  NameSpaceOID,
+		"x500": NameSpaceX500,
+	}
+)
+
+// RegisterNamespace registers id as the namespace called name so it can be
+// looked up with LookupNamespace.  The well known namespaces are registered as
+// "dns", "url", "oid" and "x500".
+//
+// Registering the same id twice under a name is a no-op.  If name is already
+// registered with a different id a NamespaceRegisteredError is returned.
+func RegisterNamespace(name string, id UUID) error {
+	defer namespaceMu.Unlock()
+	namespaceMu.Lock()
+	if old, ok := namespaces[name]; ok && old != id {
+		return errors.NamespaceRegisteredError{Name: name}
+	}
+	namespaces[name] = id
+	return nil
+}
+
+// LookupNamespace returns the namespace registered as name.  The boolean is
+// false if no namespace is registered under name.
+func LookupNamespace(name string) (UUID, bool) {
+	defer namespaceMu.RUnlock()
+	namespaceMu.RLock()
+	id, ok := namespaces[name]
+	return id, ok
+}
+
+// MustLookupNamespace is like LookupNamespace but panics if name is not
+// registered.
+func MustLookupNamespace(name string) UUID {
+	id, ok := LookupNamespace(name)
+	if !ok {
+		panic(`uuid: LookupNamespace(` + name + `): namespace not registered`)
+	}
+	return id
+}
+
+// Namespace returns the default namespace used by NewV3 and NewV5.
+func Namespace() UUID {
+	return defaultGenerator.Namespace()
+}
+
+// Namespace returns the namespace of g used for Version 3 and 5 UUIDs.
+func (g *Generator) Namespace() UUID {
+	defer g.nsMu.Unlock()
+	g.nsMu.Lock()
+	return g.namespace
+}
+
+// SetNamespace sets the default namespace used by NewV3 and NewV5.
+func SetNamespace(ns UUID) {
+	defaultGenerator.SetNamespace(ns)
+}
+
+// SetNamespace sets the namespace of g used for Version 3 and 5 UUIDs.
+func (g *Generator) SetNamespace(ns UUID) {
+	defer g.nsMu.Unlock()
+	g.nsMu.Lock()
+	g.namespace = ns
+}
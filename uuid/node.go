@@ -1,23 +1,20 @@
 package uuid
 
-import (
-	"sync"
-)
-
-var (
-	nodeMu sync.Mutex
-	ifname string  // name of interface being used
-	nodeID [6]byte // hardware for version 1 UUIDs
-	zeroID [6]byte // nodeID with only 0's
-)
+var zeroID [6]byte // nodeID with only 0's
 
 // NodeInterface returns the name of the interface from which the NodeID was
 // derived.  The interface "user" is returned if the NodeID was set by
 // SetNodeID.
 func NodeInterface() string {
-	defer nodeMu.Unlock()
-	nodeMu.Lock()
-	return ifname
+	return defaultGenerator.NodeInterface()
+}
+
+// NodeInterface returns the name of the interface from which the Node ID of g
+// was derived, see NodeInterface.
+func (g *Generator) NodeInterface() string {
+	defer g.nodeMu.Unlock()
+	g.nodeMu.Lock()
+	return g.ifname
 }
 
 // SetNodeInterface selects the hardware address to be used for Version 1 UUIDs.
@@ -27,25 +24,33 @@ func NodeInterface() string {
 //
 // SetNodeInterface never fails when name is "".
 func SetNodeInterface(name string) bool {
-	defer nodeMu.Unlock()
-	nodeMu.Lock()
-	return setNodeInterface(name)
+	return defaultGenerator.SetNodeInterface(name)
 }
 
-func setNodeInterface(name string) bool {
+// SetNodeInterface selects the hardware address used by g, see
+// SetNodeInterface.
+func (g *Generator) SetNodeInterface(name string) bool {
+	defer g.nodeMu.Unlock()
+	g.nodeMu.Lock()
+	return g.setNodeInterface(name)
+}
+
+func (g *Generator) setNodeInterface(name string) bool {
 	iname, addr := getHardwareInterface(name) // null implementation for js
 	if iname != "" && addr != nil {
-		ifname = iname
-		copy(nodeID[:], addr)
+		g.ifname = iname
+		copy(g.nodeID[:], addr)
 		return true
 	}
 
 	// We found no interfaces with a valid hardware address.  If name
 	// does not specify a specific interface generate a random Node ID
-	// (section 4.1.6)
+	// (section 4.1.6).  The multicast bit is set so it cannot collide with
+	// a real hardware address (RFC 9562 section 6.10).
 	if name == "" {
-		ifname = "random"
-		randomBits(nodeID[:])
+		g.ifname = "random"
+		g.randomBits(g.nodeID[:])
+		g.nodeID[0] |= 0x01
 		return true
 	}
 	return false
@@ -54,26 +59,41 @@ func setNodeInterface(name string) bool {
 // NodeID returns a slice of a copy of the current Node ID, setting the Node ID
 // if not already set.
 func NodeID() []byte {
-	defer nodeMu.Unlock()
-	nodeMu.Lock()
-	if nodeID == zeroID {
-		setNodeInterface("")
-	}
-	nid := nodeID
+	return defaultGenerator.NodeID()
+}
+
+// NodeID returns a slice of a copy of the Node ID of g, see NodeID.
+func (g *Generator) NodeID() []byte {
+	nid := g.node()
 	return nid[:]
 }
 
+// node returns the Node ID of g, setting it if not already set.
+func (g *Generator) node() [6]byte {
+	defer g.nodeMu.Unlock()
+	g.nodeMu.Lock()
+	if g.nodeID == zeroID {
+		g.setNodeInterface("")
+	}
+	return g.nodeID
+}
+
 // SetNodeID sets the Node ID to be used for Version 1 UUIDs.  The first 6 bytes
 // of id are used.  If id is less than 6 bytes then false is returned and the
 // Node ID is not set.
 func SetNodeID(id []byte) bool {
+	return defaultGenerator.SetNodeID(id)
+}
+
+// SetNodeID sets the Node ID used by g, see SetNodeID.
+func (g *Generator) SetNodeID(id []byte) bool {
 	if len(id) < 6 {
 		return false
 	}
-	defer nodeMu.Unlock()
-	nodeMu.Lock()
-	copy(nodeID[:], id)
-	ifname = "user"
+	defer g.nodeMu.Unlock()
+	g.nodeMu.Lock()
+	copy(g.nodeID[:], id)
+	g.ifname = "user"
 	return true
 }
 
@@ -0,0 +1,171 @@
+package uuid
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+// A Format is a textual encoding of a UUID.
+type Format int
+
+// Formats recognized by ParseWithOptions.
+const (
+	FormatCanonical Format = iota // xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+	FormatURN                     // urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+	FormatBraced                  // {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}
+	FormatCompact                 // xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+	FormatBase64                  // standard or URL safe base64, padded or not
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatCanonical:
+		return "canonical"
+	case FormatURN:
+		return "urn"
+	case FormatBraced:
+		return "braced"
+	case FormatCompact:
+		return "compact"
+	case FormatBase64:
+		return "base64"
+	}
+	return fmt.Sprintf("Format%d", int(f))
+}
+
+// A ParseMode selects which inputs ParseWithOptions accepts.
+type ParseMode int
+
+const (
+	// ParseModeStrict only accepts the RFC 9562 form
+	// xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx with either all lower case or all
+	// upper case hex digits.
+	ParseModeStrict ParseMode = iota
+
+	// ParseModeLenient accepts every Format, surrounded by any amount of white
+	// space.
+	ParseModeLenient
+)
+
+// ParseOptions configures ParseWithOptions.
+type ParseOptions struct {
+	Mode ParseMode
+}
+
+// ParseWithOptions decodes s into a UUID according to opts and reports the
+// Format s was written in.  Invalid characters are reported as an
+// errors.ParseError holding their offset in s.
+func ParseWithOptions(s string, opts ParseOptions) (UUID, Format, error) {
+	if opts.Mode != ParseModeLenient {
+		uuid, err := parseStrict(s)
+		return uuid, FormatCanonical, err
+	}
+
+	t := strings.TrimSpace(s)
+	off := strings.Index(s, t)
+
+	var (
+		uuid UUID
+		f    Format
+		err  error
+	)
+	switch len(t) {
+	case 36:
+		f = FormatCanonical
+	case 36 + 9:
+		f = FormatURN
+	case 36 + 2:
+		f = FormatBraced
+	case 32:
+		f = FormatCompact
+	case 22, 24:
+		uuid, err = parseBase64(t)
+		return uuid, FormatBase64, shiftError(err, off)
+	default:
+		return uuid, f, errors.InvalidLengthError{Len: len(t)}
+	}
+	uuid, err = Parse(t)
+	return uuid, f, shiftError(err, off)
+}
+
+// parseStrict parses the RFC 9562 form of a UUID, rejecting hex digits of
+// mixed case.
+func parseStrict(s string) (UUID, error) {
+	if len(s) != 36 {
+		return Nil, errors.InvalidLengthError{Len: len(s)}
+	}
+	uuid, err := Parse(s)
+	if err != nil {
+		return Nil, err
+	}
+	var lower, upper bool
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case 'a' <= c && c <= 'f':
+			lower = true
+		case 'A' <= c && c <= 'F':
+			upper = true
+		}
+		if lower && upper {
+			return Nil, errors.ParseError{
+				Kind:     errors.KindUUID,
+				Offset:   i,
+				Byte:     s[i],
+				Expected: "hex digit of the same case",
+				Err:      errors.ErrInvalidUUIDFormat,
+			}
+		}
+	}
+	return uuid, nil
+}
+
+// parseBase64 decodes the 22 character unpadded or 24 character padded base64
+// form of a UUID.  The URL safe alphabet is used if s contains '-' or '_'.
+func parseBase64(s string) (UUID, error) {
+	enc := base64.StdEncoding
+	if strings.ContainsAny(s, "-_") {
+		enc = base64.URLEncoding
+	}
+	if len(s) == 22 {
+		enc = enc.WithPadding(base64.NoPadding)
+	}
+	return decodeBase64(enc, s)
+}
+
+// decodeBase64 decodes the UUID encoded in s with enc.  s is decoded into a
+// buffer large enough for any input of its length, padded inputs of 24
+// characters may hold up to 18 bytes.
+func decodeBase64(enc *base64.Encoding, s string) (UUID, error) {
+	var uuid UUID
+	buf := make([]byte, enc.DecodedLen(len(s)))
+	n, err := enc.Strict().Decode(buf, []byte(s))
+	if off, ok := err.(base64.CorruptInputError); ok {
+		return Nil, errors.ParseError{
+			Kind:     errors.KindUUID,
+			Offset:   int(off),
+			Byte:     s[min(int(off), len(s)-1)],
+			Expected: "base64 character",
+			Err:      errors.ErrInvalidUUIDFormat,
+		}
+	}
+	if err != nil {
+		return Nil, err
+	}
+	if n != Size {
+		return Nil, errors.InvalidLengthError{Len: len(s)}
+	}
+	copy(uuid[:], buf)
+	return uuid, nil
+}
+
+// shiftError moves the offset of a ParseError by off.
+func shiftError(err error, off int) error {
+	if pe, ok := err.(errors.ParseError); ok {
+		pe.Offset += off
+		return pe
+	}
+	return err
+}
@@ -0,0 +1,36 @@
+package uuid
+
+import "testing"
+
+func TestParseBase64Malformed(t *testing.T) {
+	for _, s := range []string{
+		"AAAAAAAAAAAAAAAAAAAAAAA=", // 17 bytes
+		"AAAAAAAAAAAAAAAAAAAAAAAA", // 18 bytes
+		"AAAAAAAAAAAAAAAAAAAAA===",
+		"AAAAAAAAAAAAAAAAAAAA====",
+		"AAAAAAAAAAAAAAAAAAAAA=",
+		"AAAAAAAAAAAAAAAAAAAA==",
+		"AAAAAAAAAAAAAAAAAAAAA!",
+		"AAAAAAAAAAAAAAAAAAAAAB", // non-zero trailing bits
+		"AAAAAAAAAAAAAAAAAAAAAB==",
+	} {
+		if uuid, _, err := ParseWithOptions(s, ParseOptions{Mode: ParseModeLenient}); err == nil {
+			t.Errorf("ParseWithOptions(%q) = %v, want an error", s, uuid)
+		}
+	}
+}
+
+func TestParseBase64(t *testing.T) {
+	want := Must(Parse("f81d4fae-7dec-11d0-a765-00a0c91e6bf6"))
+	for _, s := range []string{
+		"+B1Prn3sEdCnZQCgyR5r9g",
+		"+B1Prn3sEdCnZQCgyR5r9g==",
+		"-B1Prn3sEdCnZQCgyR5r9g",
+		" -B1Prn3sEdCnZQCgyR5r9g== ",
+	} {
+		uuid, f, err := ParseWithOptions(s, ParseOptions{Mode: ParseModeLenient})
+		if err != nil || uuid != want || f != FormatBase64 {
+			t.Errorf("ParseWithOptions(%q) = %v, %v, %v, want %v", s, uuid, f, err, want)
+		}
+	}
+}
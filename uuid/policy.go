@@ -0,0 +1,68 @@
+package uuid
+
+import (
+	"slices"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+// A Policy restricts the UUIDs accepted by ValidateWith beyond their syntax.
+// The zero Policy accepts every UUID.
+type Policy struct {
+	// Versions lists the accepted versions.  Any version is accepted if it
+	// is empty.
+	Versions []Version
+
+	// RFCVariant requires the RFC 9562 (RFC 4122) variant.
+	RFCVariant bool
+
+	// RejectNil and RejectMax reject the Nil and Max UUIDs.  They take
+	// precedence over Versions and RFCVariant, which Nil and Max do not
+	// satisfy anyway.
+	RejectNil bool
+	RejectMax bool
+
+	// RejectHardwareNode rejects Version 1, 2 and 6 UUIDs whose Node ID is a
+	// hardware address, i.e. has the multicast bit cleared, so that IDs
+	// leaking the MAC address of their generator are refused.
+	RejectHardwareNode bool
+}
+
+// ValidateWith returns an error if s is not a UUID accepted by Validate or if
+// it violates p.
+func ValidateWith(s string, p Policy) error {
+	uuid, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	return uuid.ValidateWith(p)
+}
+
+// ValidateWith returns an error if uuid violates p:
+//
+//	errors.ErrNilUUID or errors.ErrMaxUUID for a rejected Nil or Max UUID,
+//	errors.ErrInvalidVariant if the variant is not RFC 9562,
+//	an errors.InvalidVersionError if the version is not allowed,
+//	errors.ErrHardwareNodeID if the Node ID is a hardware address.
+func (uuid UUID) ValidateWith(p Policy) error {
+	switch {
+	case p.RejectNil && uuid == Nil:
+		return errors.ErrNilUUID
+	case p.RejectMax && uuid == Max:
+		return errors.ErrMaxUUID
+	case p.RFCVariant && uuid.Variant() != RFC4122:
+		return errors.ErrInvalidVariant
+	}
+
+	v := uuid.Version()
+	if len(p.Versions) > 0 && !slices.Contains(p.Versions, v) {
+		return errors.InvalidVersionError{Version: byte(v)}
+	}
+	if p.RejectHardwareNode && uuid.Variant() == RFC4122 && uuid[10]&0x01 == 0 {
+		switch v {
+		case 1, 2, 6:
+			return errors.ErrHardwareNodeID
+		}
+	}
+	return nil
+}
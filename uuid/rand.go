@@ -3,21 +3,10 @@ package uuid
 import (
 	"crypto/rand"
 	"io"
-	"sync"
 )
 
 const randPoolSize = 16 * 16
 
-var (
-	// Zero is special form of UUID that is specified to have all
-	// 128 bits set to zero.
-	rander      = rand.Reader
-	poolEnabled = false
-	poolMu      sync.Mutex
-	poolPos     = randPoolSize     // protected with poolMu
-	pool        [randPoolSize]byte // protected with poolMu
-)
-
 // SetRand sets the random number generator to r, which implements io.Reader.
 // If r.Read returns an error when the package requests random data then
 // a panic will be issued.
@@ -25,11 +14,16 @@ var (
 // Calling SetRand with nil sets the random number generator to the default
 // generator.
 func SetRand(r io.Reader) {
+	defaultGenerator.SetRand(r)
+}
+
+// SetRand sets the random number generator of g to r, see SetRand.
+func (g *Generator) SetRand(r io.Reader) {
 	if r == nil {
-		rander = rand.Reader
+		g.rander = rand.Reader
 		return
 	}
-	rander = r
+	g.rander = r
 }
 
 // EnableRandPool enables internal randomness pool used for Random
@@ -44,7 +38,12 @@ func SetRand(r io.Reader) {
 // only be called when there is no possibility that New or any other
 // UUID Version 4 generation function will be called concurrently.
 func EnableRandPool() {
-	poolEnabled = true
+	defaultGenerator.EnableRandPool()
+}
+
+// EnableRandPool enables the randomness pool of g, see EnableRandPool.
+func (g *Generator) EnableRandPool() {
+	g.poolEnabled = true
 }
 
 // DisableRandPool disables the randomness pool if it was previously
@@ -54,8 +53,13 @@ func EnableRandPool() {
 // only be called when there is no possibility that New or any other
 // UUID Version 4 generation function will be called concurrently.
 func DisableRandPool() {
-	poolEnabled = false
-	defer poolMu.Unlock()
-	poolMu.Lock()
-	poolPos = randPoolSize
+	defaultGenerator.DisableRandPool()
+}
+
+// DisableRandPool disables the randomness pool of g, see DisableRandPool.
+func (g *Generator) DisableRandPool() {
+	g.poolEnabled = false
+	defer g.poolMu.Unlock()
+	g.poolMu.Lock()
+	g.poolPos = randPoolSize
 }
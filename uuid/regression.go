@@ -0,0 +1,162 @@
+package uuid
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+// A RegressionPolicy selects what a Generator does when the clock goes
+// backwards between two time-based (Version 1, 2, 6 or 7) UUIDs.
+type RegressionPolicy byte
+
+const (
+	// RegressionBumpSequence increments the clock sequence of Version 1, 2 and
+	// 6 UUIDs as described in RFC 9562 and keeps the last timestamp for
+	// Version 7 UUIDs.  This is the default.
+	RegressionBumpSequence = RegressionPolicy(iota)
+	// RegressionWait blocks until the clock has caught up with the last
+	// timestamp, or fails with a ClockRegressionError if that takes longer
+	// than the maximum wait, see WithRegressionMaxWait.  Clocks that do not
+	// advance on their own, such as clock.Fixed, never catch up.
+	RegressionWait
+	// RegressionError makes generation fail with a ClockRegressionError.
+	RegressionError
+	// RegressionKeepLast keeps using the last timestamp, incremented by the
+	// smallest unit the version can represent, without touching the clock
+	// sequence.
+	RegressionKeepLast
+)
+
+func (p RegressionPolicy) String() string {
+	switch p {
+	case RegressionBumpSequence:
+		return "BumpSequence"
+	case RegressionWait:
+		return "Wait"
+	case RegressionError:
+		return "Error"
+	case RegressionKeepLast:
+		return "KeepLast"
+	}
+	return fmt.Sprintf("RegressionPolicy%d", int(p))
+}
+
+// A RegressionEvent describes a clock regression detected by a Generator.
+type RegressionEvent struct {
+	Version Version          // version of the UUID being generated
+	Last    time.Time        // time of the previous UUID
+	Now     time.Time        // time read from the clock
+	Policy  RegressionPolicy // policy applied to the regression
+}
+
+// Delta returns how far the clock went backwards.
+func (e RegressionEvent) Delta() time.Duration {
+	return e.Last.Sub(e.Now)
+}
+
+// A RegressionHook is called by a Generator for every clock regression it
+// detects, before the RegressionPolicy is applied.  It is called with the
+// Generator's time lock held and must not generate UUIDs from the same
+// Generator.
+type RegressionHook func(RegressionEvent)
+
+// WithRegressionPolicy sets the RegressionPolicy of the Generator.
+func WithRegressionPolicy(p RegressionPolicy) Option {
+	return func(g *Generator) {
+		g.regressionPolicy = p
+	}
+}
+
+// DefaultRegressionMaxWait is the longest RegressionWait blocks by default.
+const DefaultRegressionMaxWait = time.Second
+
+// WithRegressionMaxWait sets the longest the RegressionWait policy waits for
+// the clock to catch up before failing with a ClockRegressionError.  Passing 0
+// results in DefaultRegressionMaxWait.
+func WithRegressionMaxWait(d time.Duration) Option {
+	return func(g *Generator) {
+		if d <= 0 {
+			d = DefaultRegressionMaxWait
+		}
+		g.regressionMaxWait = d
+	}
+}
+
+// WithRegressionHook sets the RegressionHook of the Generator.
+func WithRegressionHook(h RegressionHook) Option {
+	return func(g *Generator) {
+		g.regressionHook = h
+	}
+}
+
+// SetRegressionPolicy sets the RegressionPolicy used by the package level
+// functions.
+func SetRegressionPolicy(p RegressionPolicy) {
+	defaultGenerator.SetRegressionPolicy(p)
+}
+
+// SetRegressionPolicy sets the RegressionPolicy of g.
+func (g *Generator) SetRegressionPolicy(p RegressionPolicy) {
+	defer g.timeMu.Unlock()
+	g.timeMu.Lock()
+	g.regressionPolicy = p
+}
+
+// SetRegressionHook sets the RegressionHook used by the package level
+// functions.  Passing nil removes the hook.
+func SetRegressionHook(h RegressionHook) {
+	defaultGenerator.SetRegressionHook(h)
+}
+
+// SetRegressionHook sets the RegressionHook of g.  Passing nil removes the
+// hook.
+func (g *Generator) SetRegressionHook(h RegressionHook) {
+	defer g.timeMu.Unlock()
+	g.timeMu.Lock()
+	g.regressionHook = h
+}
+
+// regressed reports a clock regression from last to now to the hook of g and
+// applies the RegressionWait and RegressionError policies.  It returns the
+// time to continue with: now, or the caught up time for RegressionWait.
+//
+// regressed must be called with g.timeMu held.  RegressionWait releases it
+// while sleeping, so callers must not rely on state read before the call.
+func (g *Generator) regressed(v Version, last, now time.Time) (time.Time, error) {
+	if g.regressionHook != nil {
+		g.regressionHook(RegressionEvent{Version: v, Last: last, Now: now, Policy: g.regressionPolicy})
+	}
+
+	switch g.regressionPolicy {
+	case RegressionError:
+		return now, errors.ClockRegressionError{Delta: last.Sub(now)}
+	case RegressionWait:
+		maxWait := g.regressionMaxWait
+		if maxWait <= 0 {
+			maxWait = DefaultRegressionMaxWait
+		}
+		if last.Sub(now) > maxWait {
+			return now, errors.ClockRegressionError{Delta: last.Sub(now)}
+		}
+		deadline := time.Now().Add(maxWait)
+		for now.Before(last) {
+			if !time.Now().Before(deadline) {
+				return now, errors.ClockRegressionError{Delta: last.Sub(now)}
+			}
+			g.timeMu.Unlock()
+			time.Sleep(min(last.Sub(now), time.Millisecond))
+			g.timeMu.Lock()
+			now = g.now()
+		}
+	}
+	return now, nil
+}
+
+// gregorianTime returns the time.Time of t, in 100s of nanoseconds since
+// 15 Oct 1582.
+func gregorianTime(t uint64) time.Time {
+	sec, nsec := Time(t).UnixTime()
+	return time.Unix(sec, nsec)
+}
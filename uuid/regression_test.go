@@ -0,0 +1,70 @@
+package uuid
+
+import (
+	e "errors"
+	"testing"
+	"time"
+
+	"github.com/fajarnugraha37/goid/clock"
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+func TestRegressionWaitIsBounded(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := clock.NewFake(start, 0)
+	g := NewGenerator(WithClock(c), WithRegressionPolicy(RegressionWait), WithRegressionMaxWait(50*time.Millisecond))
+	if _, err := g.NewV1(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A regression larger than the maximum wait fails immediately.
+	c.Set(start.Add(-time.Hour))
+	if _, err := g.NewV1(); !e.Is(err, errors.ErrClockRegression) {
+		t.Fatalf("NewV1 = %v, want a ClockRegressionError", err)
+	}
+
+	// The fake clock never catches up on its own, so the wait times out.
+	c.Set(start.Add(-10 * time.Millisecond))
+	if _, err := g.NewV1(); !e.Is(err, errors.ErrClockRegression) {
+		t.Fatalf("NewV1 = %v, want a ClockRegressionError", err)
+	}
+}
+
+func TestRegressionWaitReleasesLock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := clock.NewFake(start, 0)
+	g := NewGenerator(WithClock(c), WithRegressionPolicy(RegressionWait), WithRegressionMaxWait(time.Second))
+	if _, err := g.NewV1(); err != nil {
+		t.Fatal(err)
+	}
+
+	c.Set(start.Add(-10 * time.Millisecond))
+	done := make(chan error, 1)
+	go func() {
+		_, err := g.NewV1()
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	locked := make(chan struct{})
+	go func() {
+		g.ClockSequence()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("ClockSequence blocked while NewV1 was waiting")
+	}
+	select {
+	case err := <-done:
+		t.Fatalf("NewV1 returned %v before the clock caught up", err)
+	default:
+	}
+
+	// Let the clock catch up to end the wait.
+	c.Set(start.Add(time.Millisecond))
+	if err := <-done; err != nil {
+		t.Fatalf("NewV1 = %v after the clock caught up", err)
+	}
+}
@@ -53,3 +53,77 @@ func (uuid *UUID) Scan(src interface{}) error {
 func (uuid UUID) Value() (driver.Value, error) {
 	return uuid.String(), nil
 }
+
+// Column adapters wrap a UUID to choose how it is stored in a database column.
+// UUID itself is written as text and scanned from text or 16 bytes.  Pick the
+// adapter matching the column type:
+//
+//	PostgreSQL uuid                  Text (or UUID)
+//	MySQL CHAR(36), SQLite TEXT      Text
+//	MySQL BINARY(16), SQLite BLOB    Binary
+//	MySQL 8 UUID_TO_BIN(id, 1)       MySQLSwapped
+//	SQL Server uniqueidentifier      GUID
+//
+// Like UUID, the adapters leave the UUID unchanged when scanning NULL, use
+// NullUUID for nullable columns.
+
+// Text stores a UUID as its 36 character string form.
+type Text struct {
+	UUID UUID
+}
+
+// Scan implements sql.Scanner, see UUID.Scan.
+func (t *Text) Scan(src interface{}) error {
+	return t.UUID.Scan(src)
+}
+
+// Value implements sql.Valuer, returning the string form of the UUID.
+func (t Text) Value() (driver.Value, error) {
+	return t.UUID.String(), nil
+}
+
+// Binary stores a UUID as its 16 bytes in RFC 9562 byte order.
+type Binary struct {
+	UUID UUID
+}
+
+// Scan implements sql.Scanner, see UUID.Scan.
+func (b *Binary) Scan(src interface{}) error {
+	return b.UUID.Scan(src)
+}
+
+// Value implements sql.Valuer, returning the 16 bytes of the UUID.
+func (b Binary) Value() (driver.Value, error) {
+	return b.UUID[:], nil
+}
+
+// MySQLSwapped stores a UUID as 16 bytes in the order produced by MySQL's
+// UUID_TO_BIN(id, 1), which moves the time_hi_and_version and time_mid fields
+// in front of time_low so that Version 1 UUIDs are stored in time order.
+// Values are read back like BIN_TO_UUID(id, 1) does.
+type MySQLSwapped struct {
+	UUID UUID
+}
+
+// Scan implements sql.Scanner.  16 byte values are read in swapped byte
+// order, other values are scanned like a UUID.
+func (s *MySQLSwapped) Scan(src interface{}) error {
+	if b, ok := src.([]byte); ok && len(b) == Size {
+		copy(s.UUID[0:4], b[4:8])
+		copy(s.UUID[4:6], b[2:4])
+		copy(s.UUID[6:8], b[0:2])
+		copy(s.UUID[8:], b[8:])
+		return nil
+	}
+	return s.UUID.Scan(src)
+}
+
+// Value implements sql.Valuer, returning the UUID in swapped byte order.
+func (s MySQLSwapped) Value() (driver.Value, error) {
+	b := make([]byte, Size)
+	copy(b[0:2], s.UUID[6:8])
+	copy(b[2:4], s.UUID[4:6])
+	copy(b[4:8], s.UUID[0:4])
+	copy(b[8:], s.UUID[8:])
+	return b, nil
+}
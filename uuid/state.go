@@ -0,0 +1,241 @@
+package uuid
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	e "errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+// State is the generator state RFC 9562 (section 6.3) recommends keeping in
+// stable storage, so that a restart with a rewound clock does not produce
+// duplicate Version 1, 2 or 6 UUIDs.
+type State struct {
+	LastTime      Time    // timestamp of the last UUID, or a time in the near future
+	ClockSequence uint16  // clock sequence, only the lower 14 bits are used
+	NodeID        [6]byte // node ID the clock sequence belongs to
+}
+
+// A StateStore keeps a State in stable storage.  Load returns ErrNoState when
+// nothing has been saved yet.
+type StateStore interface {
+	Load() (State, error)
+	Save(State) error
+}
+
+// WithStateStore makes the Generator restore its clock sequence, last
+// timestamp and, when it would otherwise be random, its Node ID from store the
+// first time a time-based UUID is generated.  The state is then checkpointed to
+// store whenever interval has passed since the last checkpoint, with the
+// timestamp set interval into the future as RFC 9562 suggests for periodic
+// writes.  An interval of zero saves the state for every UUID.  Use Checkpoint
+// to save the exact state, e.g. at shutdown.
+//
+// Errors loading or saving the state are returned by the generation functions.
+func WithStateStore(store StateStore, interval time.Duration) Option {
+	return func(g *Generator) {
+		g.setStateStore(store, interval)
+	}
+}
+
+// SetStateStore sets the StateStore used by the package level functions, see
+// WithStateStore.  Passing nil disables persistence.
+func SetStateStore(store StateStore, interval time.Duration) {
+	defaultGenerator.SetStateStore(store, interval)
+}
+
+// SetStateStore sets the StateStore of g, see WithStateStore.  Passing nil
+// disables persistence.
+func (g *Generator) SetStateStore(store StateStore, interval time.Duration) {
+	defer g.timeMu.Unlock()
+	g.timeMu.Lock()
+	g.setStateStore(store, interval)
+}
+
+func (g *Generator) setStateStore(store StateStore, interval time.Duration) {
+	g.store = store
+	g.storeInterval = uint64(interval / 100)
+	g.storeLoaded = false
+	g.storeSaved = 0
+	g.storeLast = 0
+}
+
+// Checkpoint saves the current state of g to its StateStore.  It is a no-op
+// when g has no StateStore.
+func (g *Generator) Checkpoint() error {
+	defer g.timeMu.Unlock()
+	g.timeMu.Lock()
+	if g.store == nil {
+		return nil
+	}
+	if err := g.loadState(); err != nil {
+		return err
+	}
+	return g.saveState(max(g.lasttime, g.storeLast))
+}
+
+// loadState restores the state of g from its StateStore once.  The stored
+// clock sequence and timestamp are only used if they belong to the Node ID of
+// g, otherwise a fresh clock sequence is kept.  The stored timestamp is kept
+// apart from g.lasttime: it is usually padded into the future by the
+// checkpoint interval, so getTime only compares it once with the clock.
+//
+// loadState must be called with g.timeMu held.
+func (g *Generator) loadState() error {
+	if g.storeLoaded {
+		return nil
+	}
+	st, err := g.store.Load()
+	if e.Is(err, errors.ErrNoState) {
+		g.storeLoaded = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	g.nodeMu.Lock()
+	if g.nodeID == zeroID {
+		g.setNodeInterface("")
+	}
+	if g.ifname == "random" && st.NodeID != zeroID {
+		g.nodeID = st.NodeID
+		g.ifname = "state"
+	}
+	node := g.nodeID
+	g.nodeMu.Unlock()
+
+	g.storeLoaded = true
+	if node != st.NodeID {
+		return nil
+	}
+	g.clockSeq = (st.ClockSequence & 0x3fff) | 0x8000
+	g.storeLast = uint64(st.LastTime)
+	return nil
+}
+
+// checkpoint saves the state of g if its checkpoint interval has passed since
+// the last save.
+//
+// checkpoint must be called with g.timeMu held.
+func (g *Generator) checkpoint() error {
+	if g.lasttime < g.storeSaved {
+		return nil
+	}
+	return g.saveState(g.lasttime + g.storeInterval)
+}
+
+// saveState must be called with g.timeMu held.
+func (g *Generator) saveState(last uint64) error {
+	st := State{
+		LastTime:      Time(last),
+		ClockSequence: g.clockSeq & 0x3fff,
+		NodeID:        g.node(),
+	}
+	if err := g.store.Save(st); err != nil {
+		return err
+	}
+	g.storeSaved = last
+	return nil
+}
+
+// FileStateStore is a StateStore keeping the State as JSON in a file.  Save
+// writes a temporary file in the same directory, syncs it and renames it over
+// the previous state, so the file always holds a complete State.
+//
+// A FileStateStore is safe for concurrent use.
+type FileStateStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+type fileState struct {
+	LastTime      int64  `json:"last_time"`
+	ClockSequence uint16 `json:"clock_sequence"`
+	NodeID        string `json:"node_id"`
+}
+
+// NewFileStateStore returns a FileStateStore keeping the State in path.
+func NewFileStateStore(path string) *FileStateStore {
+	return &FileStateStore{path: path}
+}
+
+// Load implements StateStore.  ErrNoState is returned if the file does not
+// exist.
+func (s *FileStateStore) Load() (State, error) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	var st State
+	data, err := os.ReadFile(s.path)
+	if e.Is(err, os.ErrNotExist) {
+		return st, errors.ErrNoState
+	}
+	if err != nil {
+		return st, err
+	}
+
+	var fs fileState
+	if err := json.Unmarshal(data, &fs); err != nil {
+		return st, err
+	}
+	node, err := hex.DecodeString(fs.NodeID)
+	if err != nil {
+		return st, err
+	}
+	if len(node) != len(st.NodeID) {
+		return st, errors.InvalidLengthError{Len: len(node)}
+	}
+	st.LastTime = Time(fs.LastTime)
+	st.ClockSequence = fs.ClockSequence
+	copy(st.NodeID[:], node)
+	return st, nil
+}
+
+// Save implements StateStore.
+func (s *FileStateStore) Save(st State) error {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	data, err := json.Marshal(fileState{
+		LastTime:      int64(st.LastTime),
+		ClockSequence: st.ClockSequence,
+		NodeID:        hex.EncodeToString(st.NodeID[:]),
+	})
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(s.path)
+	f, err := os.CreateTemp(dir, filepath.Base(s.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, s.path)
+	}
+	if err != nil {
+		os.Remove(tmp) //nolint:errcheck
+		return err
+	}
+
+	// Sync the directory so the rename itself is durable.  Not every
+	// platform supports syncing directories, so this is best effort.
+	if d, err := os.Open(dir); err == nil {
+		d.Sync()  //nolint:errcheck
+		d.Close() //nolint:errcheck
+	}
+	return nil
+}
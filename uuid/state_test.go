@@ -0,0 +1,67 @@
+package uuid
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fajarnugraha37/goid/clock"
+)
+
+func TestStateStoreRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "uuid.json")
+	node := []byte{0x02, 0x00, 0x00, 0x00, 0x00, 0x01}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	c := clock.NewFake(start, time.Microsecond)
+	g1 := NewGenerator(WithClock(c), WithNodeID(node), WithStateStore(NewFileStateStore(path), 10*time.Second))
+	var last UUID
+	for i := 0; i < 10; i++ {
+		u, err := g1.NewV1()
+		if err != nil {
+			t.Fatal(err)
+		}
+		last = u
+	}
+
+	restart := func(at time.Time) (UUID, int) {
+		t.Helper()
+		regressions := 0
+		g := NewGenerator(
+			WithClock(clock.Fixed(at)),
+			WithNodeID(node),
+			WithStateStore(NewFileStateStore(path), 10*time.Second),
+			WithRegressionPolicy(RegressionError),
+			WithRegressionHook(func(RegressionEvent) { regressions++ }),
+		)
+		u, err := g.NewV1()
+		if err != nil {
+			t.Fatalf("NewV1 after restart: %v", err)
+		}
+		return u, regressions
+	}
+
+	// Restarting within the checkpoint interval must not look like a clock
+	// regression, but the clock sequence has to change.
+	u, regressions := restart(c.Now().Add(time.Second))
+	if regressions != 0 {
+		t.Errorf("restart within the interval reported %d regressions", regressions)
+	}
+	if u.ClockSequence() == last.ClockSequence() {
+		t.Errorf("clock sequence %d was not incremented", u.ClockSequence())
+	}
+
+	// Restarting after the stored timestamp keeps the clock sequence.
+	st, err := NewFileStateStore(path).Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sec, nsec := st.LastTime.UnixTime()
+	u2, regressions := restart(time.Unix(sec, nsec).Add(time.Minute))
+	if regressions != 0 {
+		t.Errorf("restart after the interval reported %d regressions", regressions)
+	}
+	if u2.ClockSequence() != u.ClockSequence() {
+		t.Errorf("clock sequence = %d, want %d", u2.ClockSequence(), u.ClockSequence())
+	}
+}
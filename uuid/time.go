@@ -2,7 +2,6 @@ package uuid
 
 import (
 	"encoding/binary"
-	"sync"
 	"time"
 )
 
@@ -18,14 +17,6 @@ const (
 	g1582ns100 = g1582 * 10000000 // 100s of a nanoseconds between epochs
 )
 
-var (
-	timeMu   sync.Mutex
-	lasttime uint64 // last time we returned
-	clockSeq uint16 // clock sequence for this run
-
-	timeNow = time.Now // for testing
-)
-
 // UnixTime converts t the number of seconds and nanoseconds using the Unix
 // epoch of 1 Jan 1970.
 func (t Time) UnixTime() (sec, nsec int64) {
@@ -39,32 +30,77 @@ func (t Time) UnixTime() (sec, nsec int64) {
 // clock sequence as well as adjusting the clock sequence as needed.  An error
 // is returned if the current time cannot be determined.
 func GetTime() (Time, uint16, error) {
-	defer timeMu.Unlock()
-	timeMu.Lock()
-	return getTime(nil)
+	return defaultGenerator.GetTime()
 }
 
-func getTime(customTime *time.Time) (Time, uint16, error) {
+// GetTime returns the current Time and clock sequence of g, see GetTime.
+func (g *Generator) GetTime() (Time, uint16, error) {
+	defer g.timeMu.Unlock()
+	g.timeMu.Lock()
+	return g.getTime(Version(V1), nil)
+}
+
+// getTime returns the time and clock sequence for a UUID of version v.
+// getTime must be called with g.timeMu held.
+func (g *Generator) getTime(v Version, customTime *time.Time) (Time, uint16, error) {
 	var t time.Time
 	if customTime == nil { // When not provided, use the current time
-		t = timeNow()
+		t = g.now()
 	} else {
 		t = *customTime
 	}
 
+	// Restore the clock sequence and last time from stable storage.
+	if g.store != nil {
+		if err := g.loadState(); err != nil {
+			return 0, 0, err
+		}
+	}
+
 	// If we don't have a clock sequence already, set one.
-	if clockSeq == 0 {
-		setClockSequence(-1)
+	if g.clockSeq == 0 {
+		g.setClockSequence(-1)
 	}
 	now := uint64(t.UnixNano()/100) + g1582ns100
 
+	// If the restored state is not older than now, a previous run may have
+	// used the timestamps up to now with the restored clock sequence, so
+	// increment it (RFC 9562 section 6.3).  This is not a clock regression,
+	// the stored timestamp is padded by the checkpoint interval.
+	if g.storeLast != 0 {
+		if now <= g.storeLast {
+			g.clockSeq = ((g.clockSeq + 1) & 0x3fff) | 0x8000
+		}
+		g.storeLast = 0
+	}
+
+	// If the clock went backwards apply the regression policy.  Custom
+	// times are not compared against the clock.
+	keepLast := false
+	if customTime == nil && now < g.lasttime {
+		var err error
+		if t, err = g.regressed(v, gregorianTime(g.lasttime), t); err != nil {
+			return 0, 0, err
+		}
+		now = uint64(t.UnixNano()/100) + g1582ns100
+		if g.regressionPolicy == RegressionKeepLast {
+			now = g.lasttime + 1
+			keepLast = true
+		}
+	}
+
 	// If time has gone backwards with this clock sequence then we
 	// increment the clock sequence
-	if now <= lasttime {
-		clockSeq = ((clockSeq + 1) & 0x3fff) | 0x8000
+	if now <= g.lasttime && !keepLast {
+		g.clockSeq = ((g.clockSeq + 1) & 0x3fff) | 0x8000
+	}
+	g.lasttime = now
+	if g.store != nil {
+		if err := g.checkpoint(); err != nil {
+			return 0, 0, err
+		}
 	}
-	lasttime = now
-	return Time(now), clockSeq, nil
+	return Time(now), g.clockSeq, nil
 }
 
 // ClockSequence returns the current clock sequence, generating one if not
@@ -75,41 +111,49 @@ func getTime(customTime *time.Time) (Time, uint16, error) {
 // random clock sequence is generated the first time a clock sequence is
 // requested by ClockSequence, GetTime, or NewUUID.  (section 4.2.1.1)
 func ClockSequence() int {
-	defer timeMu.Unlock()
-	timeMu.Lock()
-	return clockSequence()
+	return defaultGenerator.ClockSequence()
 }
 
-func clockSequence() int {
-	if clockSeq == 0 {
-		setClockSequence(-1)
+// ClockSequence returns the clock sequence of g, see ClockSequence.
+func (g *Generator) ClockSequence() int {
+	defer g.timeMu.Unlock()
+	g.timeMu.Lock()
+	if g.clockSeq == 0 {
+		g.setClockSequence(-1)
 	}
-	return int(clockSeq & 0x3fff)
+	return int(g.clockSeq & 0x3fff)
 }
 
 // SetClockSequence sets the clock sequence to the lower 14 bits of seq.  Setting to
 // -1 causes a new sequence to be generated.
 func SetClockSequence(seq int) {
-	defer timeMu.Unlock()
-	timeMu.Lock()
-	setClockSequence(seq)
+	defaultGenerator.SetClockSequence(seq)
 }
 
-func setClockSequence(seq int) {
+// SetClockSequence sets the clock sequence of g, see SetClockSequence.
+func (g *Generator) SetClockSequence(seq int) {
+	defer g.timeMu.Unlock()
+	g.timeMu.Lock()
+	g.setClockSequence(seq)
+}
+
+func (g *Generator) setClockSequence(seq int) {
 	if seq == -1 {
 		var b [2]byte
-		randomBits(b[:]) // clock sequence
+		g.randomBits(b[:]) // clock sequence
 		seq = int(b[0])<<8 | int(b[1])
 	}
-	oldSeq := clockSeq
-	clockSeq = uint16(seq&0x3fff) | 0x8000 // Set our variant
-	if oldSeq != clockSeq {
-		lasttime = 0
+	oldSeq := g.clockSeq
+	g.clockSeq = uint16(seq&0x3fff) | 0x8000 // Set our variant
+	if oldSeq != g.clockSeq {
+		g.lasttime = 0
 	}
 }
 
 // Time returns the time in 100s of nanoseconds since 15 Oct 1582 encoded in
-// uuid.  The time is only defined for version 1, 2, 6 and 7 UUIDs.
+// uuid.  The time is only defined for version 1, 2, 6 and 7 UUIDs, other
+// versions are decoded as if they were version 1.  Use Timestamp to tell
+// whether uuid has a time at all.
 func (uuid UUID) Time() Time {
 	var t Time
 	switch uuid.Version() {
@@ -130,6 +174,36 @@ func (uuid UUID) Time() Time {
 	return t
 }
 
+// Timestamp returns the creation time encoded in uuid as a time.Time.  The
+// boolean is false if uuid has no time field, i.e. it is not an RFC 9562
+// Version 1, 2, 6 or 7 UUID.
+//
+// Version 2 UUIDs replace time_low with the local ID, so their timestamp is
+// truncated to a multiple of 2^32 100s of nanoseconds (about 7 minutes).
+// Version 7 timestamps have millisecond precision, like ulid.ULID.Timestamp.
+func (uuid UUID) Timestamp() (time.Time, bool) {
+	if uuid.Variant() != RFC4122 {
+		return time.Time{}, false
+	}
+
+	var t Time
+	switch uuid.Version() {
+	case 1, 6:
+		t = uuid.Time()
+	case 2:
+		time := int64(binary.BigEndian.Uint16(uuid[4:6])) << 32
+		time |= int64(binary.BigEndian.Uint16(uuid[6:8])&0xfff) << 48
+		t = Time(time)
+	case 7:
+		milli := binary.BigEndian.Uint64(uuid[:8]) >> 16
+		return time.UnixMilli(int64(milli)), true
+	default:
+		return time.Time{}, false
+	}
+	sec, nsec := t.UnixTime()
+	return time.Unix(sec, nsec), true
+}
+
 // ClockSequence returns the clock sequence encoded in uuid.
 // The clock sequence is only well defined for version 1 and 2 UUIDs.
 func (uuid UUID) ClockSequence() int {
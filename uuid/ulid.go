@@ -0,0 +1,12 @@
+package uuid
+
+// FromULID returns the UUID holding the same 16 bytes as id.  Both ulid.ULID
+// and uuid.UUID are 16 byte arrays, so a ULID can be passed directly:
+//
+//	u := uuid.FromULID(*ulid.Make())
+//
+// The conversion is lossless but the result generally isn't a valid RFC 9562
+// UUID, see ulid.ULID.UUIDv7 for a Version 7 conversion.
+func FromULID(id [16]byte) UUID {
+	return UUID(id)
+}
@@ -1,17 +1,30 @@
 package uuid
 
-import "encoding/binary"
+import (
+	"encoding/binary"
 
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+// NewV1 returns a Version 1 UUID based on the current NodeID and clock
+// sequence, and the current time.  NewV1 panics if the UUID cannot be
+// generated.
 func NewV1() UUID {
-	return Must(newV1())
+	return Must(defaultGenerator.NewV1())
 }
 
-func newV1() (UUID, error) {
-	var uuid UUID
-	now, seq, err := GetTime()
+// NewV1 returns a Version 1 UUID based on the Node ID, clock sequence and
+// current time of g.
+func (g *Generator) NewV1() (UUID, error) {
+	now, seq, err := g.GetTime()
 	if err != nil {
-		return uuid, err
+		return Nil, err
 	}
+	return encodeV1(now, seq, g.node()), nil
+}
+
+func encodeV1(now Time, seq uint16, node [6]byte) UUID {
+	var uuid UUID
 
 	timeLow := uint32(now & 0xffffffff)
 	timeMid := uint16((now >> 32) & 0xffff)
@@ -22,13 +35,33 @@ func newV1() (UUID, error) {
 	binary.BigEndian.PutUint16(uuid[4:], timeMid)
 	binary.BigEndian.PutUint16(uuid[6:], timeHi)
 	binary.BigEndian.PutUint16(uuid[8:], seq)
+	copy(uuid[10:], node[:])
 
-	nodeMu.Lock()
-	if nodeID == zeroID {
-		setNodeInterface("")
+	return uuid
+}
+
+// ToV6 converts the Version 1 UUID u to the Version 6 UUID with the same
+// timestamp, clock sequence and node.  The conversion is lossless, ToV1
+// reverses it.  An InvalidVersionError is returned if u is not a Version 1
+// UUID.
+func ToV6(u UUID) (UUID, error) {
+	if u.Version() != Version(V1) {
+		return Nil, errors.InvalidVersionError{Version: byte(u.Version())}
 	}
-	copy(uuid[10:], nodeID[:])
-	nodeMu.Unlock()
+	var node [6]byte
+	copy(node[:], u[10:])
+	return encodeV6(u.Time(), binary.BigEndian.Uint16(u[8:]), node), nil
+}
 
-	return uuid, nil
+// ToV1 converts the Version 6 UUID u to the Version 1 UUID with the same
+// timestamp, clock sequence and node.  The conversion is lossless, ToV6
+// reverses it.  An InvalidVersionError is returned if u is not a Version 6
+// UUID.
+func ToV1(u UUID) (UUID, error) {
+	if u.Version() != Version(V6) {
+		return Nil, errors.InvalidVersionError{Version: byte(u.Version())}
+	}
+	var node [6]byte
+	copy(node[:], u[10:])
+	return encodeV1(u.Time(), binary.BigEndian.Uint16(u[8:]), node), nil
 }
@@ -1,17 +1,16 @@
 package uuid
 
 // NewV2 returns DCE Security UUID based on POSIX UID/GID.
+//
+// Version 2 UUIDs only have room for 64 UUIDs per domain every 7 minutes and
+// 10 seconds.  Beyond that the timestamp of the UUIDs is advanced ahead of the
+// clock instead of failing, see Generator.NewDCESecurity.
 func NewV2(domain Domain, id uint32) UUID {
-	return Must(newV2(domain, id))
+	return Must(defaultGenerator.NewV2(domain, id))
 }
 
-func newV2(domain Domain, id uint32) (UUID, error) {
-	uuid, err := NewDCESecurity(domain, id)
-	if err != nil {
-		return Nil, err
-	}
-	uuid.SetVersion(V2)
-	uuid.SetVariant(RFC4122)
-
-	return uuid, nil
+// NewV2 returns a DCE Security (Version 2) UUID generated by g, see
+// NewDCESecurity.
+func (g *Generator) NewV2(domain Domain, id uint32) (UUID, error) {
+	return g.NewDCESecurity(domain, id)
 }
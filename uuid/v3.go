@@ -1,18 +1,20 @@
 package uuid
 
-// NewV3 returns UUID based on MD5 hash of namespace UUID and name.
+// NewV3 returns a Version 3 UUID based on the MD5 hash of the default
+// namespace and name.  The default namespace is Nil unless it was changed with
+// SetNamespace, so the same name always yields the same UUID.
 func NewV3(name string) UUID {
-	return Must(newV3(name))
+	return defaultGenerator.NewV3(name)
 }
-func newV3(name string) (UUID, error) {
-	uuid, err := newV1()
-	if err != nil {
-		return Nil, nil
-	}
 
-	u := NewMD5(uuid, []byte(name))
-	u.SetVersion(V3)
-	u.SetVariant(RFC4122)
+// NewV3 is like the package level NewV3 but uses the namespace of g.
+func (g *Generator) NewV3(name string) UUID {
+	return NewV3In(g.Namespace(), name)
+}
 
-	return u, nil
+// NewV3In returns a Version 3 UUID based on the MD5 hash of ns and name.
+//
+//	NewV3In(NameSpaceDNS, "example.com")
+func NewV3In(ns UUID, name string) UUID {
+	return NewMD5(ns, []byte(name))
 }
@@ -27,10 +27,16 @@ func NewV4String() string {
 //  equivalent to the odds of creating a few tens of trillions of UUIDs in a
 //  year and having one duplicate.
 func NewV4Random() (UUID, error) {
-	if !poolEnabled {
-		return NewV4RandomFromReader(rander)
+	return defaultGenerator.NewV4()
+}
+
+// NewV4 returns a Random (Version 4) UUID read from the random number
+// generator of g, using the randomness pool if it is enabled.
+func (g *Generator) NewV4() (UUID, error) {
+	if !g.poolEnabled {
+		return NewV4RandomFromReader(g.rander)
 	}
-	return newV4RandomFromPool()
+	return g.newV4RandomFromPool()
 }
 
 // NewV4RandomFromReader returns a UUID based on bytes read from a given io.Reader.
@@ -45,20 +51,20 @@ func NewV4RandomFromReader(r io.Reader) (UUID, error) {
 	return uuid, nil
 }
 
-func newV4RandomFromPool() (UUID, error) {
+func (g *Generator) newV4RandomFromPool() (UUID, error) {
 	var uuid UUID
-	poolMu.Lock()
-	if poolPos == randPoolSize {
-		_, err := io.ReadFull(rander, pool[:])
+	g.poolMu.Lock()
+	if g.poolPos == randPoolSize {
+		_, err := io.ReadFull(g.rander, g.pool[:])
 		if err != nil {
-			poolMu.Unlock()
+			g.poolMu.Unlock()
 			return Nil, err
 		}
-		poolPos = 0
+		g.poolPos = 0
 	}
-	copy(uuid[:], pool[poolPos:(poolPos+16)])
-	poolPos += 16
-	poolMu.Unlock()
+	copy(uuid[:], g.pool[g.poolPos:(g.poolPos+16)])
+	g.poolPos += 16
+	g.poolMu.Unlock()
 
 	uuid[6] = (uuid[6] & 0x0f) | 0x40 // Version 4
 	uuid[8] = (uuid[8] & 0x3f) | 0x80 // Variant is 10
@@ -1,19 +1,20 @@
 package uuid
 
-// NewV5 returns UUID based on SHA-1 hash of namespace UUID and name.
+// NewV5 returns a Version 5 UUID based on the SHA-1 hash of the default
+// namespace and name.  The default namespace is Nil unless it was changed with
+// SetNamespace, so the same name always yields the same UUID.
 func NewV5(name string) UUID {
-	return Must(newV5(name))
+	return defaultGenerator.NewV5(name)
 }
 
-func newV5(name string) (UUID, error) {
-	uuid, err := newV1()
-	if err != nil {
-		return Nil, nil
-	}
-
-	u := NewSHA1(uuid, []byte(name))
-	u.SetVersion(V5)
-	u.SetVariant(RFC4122)
+// NewV5 is like the package level NewV5 but uses the namespace of g.
+func (g *Generator) NewV5(name string) UUID {
+	return NewV5In(g.Namespace(), name)
+}
 
-	return u, nil
+// NewV5In returns a Version 5 UUID based on the SHA-1 hash of ns and name.
+//
+//	NewV5In(NameSpaceDNS, "example.com")
+func NewV5In(ns UUID, name string) UUID {
+	return NewSHA1(ns, []byte(name))
 }
@@ -18,13 +18,13 @@ import (
 // SetClockSequence then it will be set automatically. If GetTime fails to
 // return the current NewV6 returns Nil and an error.
 func NewV6() UUID {
-	return Must(func() (UUID, error) {
-		now, seq, err := GetTime()
-		if err != nil {
-			return Nil, err
-		}
-		return generateV6(now, seq), nil
-	}())
+	return Must(defaultGenerator.NewV6())
+}
+
+// NewV6 returns a Version 6 UUID based on the Node ID, clock sequence and
+// current time of g.
+func (g *Generator) NewV6() (UUID, error) {
+	return g.NewV6WithTime(nil)
 }
 
 // NewV6WithTime returns a Version 6 UUID based on the current NodeID, clock
@@ -35,17 +35,66 @@ func NewV6() UUID {
 // are generating multiple UUIDs, it is recommended to increment the time.
 // If getTime fails to return the current NewV6WithTime returns Nil and an error.
 func NewV6WithTime(customTime *time.Time) UUID {
-	return Must(func() (UUID, error) {
-		now, seq, err := getTime(customTime)
-		if err != nil {
-			return Nil, err
-		}
-
-		return generateV6(now, seq), nil
-	}())
+	return Must(defaultGenerator.NewV6WithTime(customTime))
+}
+
+// NewV6WithTime is like NewV6 but uses customTime instead of the current time
+// of g when it is not nil.
+func (g *Generator) NewV6WithTime(customTime *time.Time) (UUID, error) {
+	g.timeMu.Lock()
+	now, seq, err := g.getTime(Version(V6), customTime)
+	g.timeMu.Unlock()
+	if err != nil {
+		return Nil, err
+	}
+	return g.generateV6(now, seq), nil
+}
+
+// V6MinForTime returns the smallest Version 6 UUID with the timestamp of t,
+// the clock sequence and node being zero.  Together with V6MaxForTime it
+// turns a time window into a key range:
+//
+//	WHERE id BETWEEN V6MinForTime(from) AND V6MaxForTime(to)
+//
+// Version 1 UUIDs store the low bits of the timestamp first and do not sort by
+// time, convert them with ToV6 to query them by time.  Times before 15 Oct 1582
+// or beyond the 60 bit timestamp range (around the year 5236) are clamped.
+func V6MinForTime(t time.Time) UUID {
+	return v6ForTime(t, 0x0000, [6]byte{})
+}
+
+// V6MaxForTime returns the largest Version 6 UUID with the timestamp of t, the
+// clock sequence and node having all bits set.  See V6MinForTime.
+func V6MaxForTime(t time.Time) UUID {
+	return v6ForTime(t, 0x3fff, [6]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
 }
 
-func generateV6(now Time, seq uint16) UUID {
+func v6ForTime(t time.Time, seq uint16, node [6]byte) UUID {
+	return encodeV6(clampedTime(t), seq|0x8000, node)
+}
+
+// v6MaxTime is the largest 60 bit timestamp of a Version 1 or 6 UUID.
+const v6MaxTime = 1<<60 - 1
+
+// clampedTime returns t in 100s of nanoseconds since 15 Oct 1582, clamped to
+// the 60 bit timestamp range.  Unlike t.UnixNano it does not overflow after
+// the year 2262.
+func clampedTime(t time.Time) Time {
+	sec := t.Unix() + g1582
+	switch {
+	case sec < 0:
+		return 0
+	case sec > v6MaxTime/10000000:
+		return v6MaxTime
+	}
+	return min(Time(sec)*10000000+Time(t.Nanosecond()/100), v6MaxTime)
+}
+
+func (g *Generator) generateV6(now Time, seq uint16) UUID {
+	return encodeV6(now, seq, g.node())
+}
+
+func encodeV6(now Time, seq uint16, node [6]byte) UUID {
 	var uuid UUID
 
 	/*
@@ -71,13 +120,7 @@ func generateV6(now Time, seq uint16) UUID {
 	binary.BigEndian.PutUint16(uuid[4:], timeMid)
 	binary.BigEndian.PutUint16(uuid[6:], timeLow)
 	binary.BigEndian.PutUint16(uuid[8:], seq)
-
-	nodeMu.Lock()
-	if nodeID == zeroID {
-		setNodeInterface("")
-	}
-	copy(uuid[10:], nodeID[:])
-	nodeMu.Unlock()
+	copy(uuid[10:], node[:])
 
 	return uuid
 }
@@ -0,0 +1,22 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestV6ForTimeRange(t *testing.T) {
+	tm := time.Date(3000, 1, 2, 3, 4, 5, 600, time.UTC)
+	sec, nsec := V6MinForTime(tm).Time().UnixTime()
+	if got := time.Unix(sec, nsec).UTC(); !got.Equal(tm) {
+		t.Errorf("V6MinForTime(%v) holds %v", tm, got)
+	}
+
+	epoch := time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC)
+	if got, want := V6MinForTime(epoch.Add(-time.Hour)), V6MinForTime(epoch); got != want {
+		t.Errorf("V6MinForTime(before 1582) = %s, want %s", got, want)
+	}
+	if got, want := V6MaxForTime(time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)), MustParse("ffffffff-ffff-6fff-bfff-ffffffffffff"); got != want {
+		t.Errorf("V6MaxForTime(9999) = %s, want %s", got, want)
+	}
+}
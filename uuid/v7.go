@@ -2,6 +2,9 @@ package uuid
 
 import (
 	"io"
+	"time"
+
+	"github.com/fajarnugraha37/goid/errors"
 )
 
 // UUID version 7 features a time-ordered value field derived from the widely
@@ -15,35 +18,134 @@ import (
 //
 // NewV7 returns a Version 7 UUID based on the current time(Unix Epoch).
 // Uses the randomness pool if it was enabled with EnableRandPool.
-// On error, NewV7 returns Nil and an error
+// When more UUIDs are requested within a millisecond than the V7Method can
+// tell apart, NewV7 waits for the next millisecond instead of panicking.  It
+// only panics if the clock does not reach it within DefaultRegressionMaxWait,
+// e.g. after SetClock(clock.Fixed(t)).
 func NewV7() UUID {
-	return Must(func() (UUID, error) {
-		uuid, err := NewV4Random()
-		if err != nil {
+	return Must(defaultGenerator.newV7Wait())
+}
+
+// NewV7 returns a Version 7 UUID based on the current time of g.  The
+// rand_a and rand_b fields are filled according to the V7Method of g.
+// ErrV7Overflow is returned when the method cannot produce a UUID greater than
+// the previous one within the same millisecond.
+func (g *Generator) NewV7() (UUID, error) {
+	uuid, err := g.NewV4()
+	if err != nil {
+		return uuid, err
+	}
+	if err := g.makeV7(uuid[:], g.rander); err != nil {
+		return Nil, err
+	}
+	return uuid, nil
+}
+
+// newV7Wait is like NewV7 but waits for the next millisecond of the clock of
+// g when the V7Method of g overflows, for at most DefaultRegressionMaxWait.
+func (g *Generator) newV7Wait() (UUID, error) {
+	deadline := time.Now().Add(DefaultRegressionMaxWait)
+	for {
+		uuid, err := g.NewV7()
+		if err != errors.ErrV7Overflow || !time.Now().Before(deadline) {
 			return uuid, err
 		}
-		makeV7(uuid[:])
-		return uuid, nil
-	}())
+		time.Sleep(nanoPerMilli - time.Duration(g.now().Nanosecond()%nanoPerMilli))
+	}
 }
 
 // NewV7FromReader returns a Version 7 UUID based on the current time(Unix Epoch).
 // it use NewRandomFromReader fill random bits.
 // On error, NewV7FromReader returns Nil and an error.
 func NewV7FromReader(r io.Reader) (UUID, error) {
+	return defaultGenerator.NewV7FromReader(r)
+}
+
+// NewV7FromReader is like NewV7 but fills the random bits from r.
+func (g *Generator) NewV7FromReader(r io.Reader) (UUID, error) {
 	uuid, err := NewV4RandomFromReader(r)
 	if err != nil {
 		return uuid, err
 	}
+	if err := g.makeV7(uuid[:], r); err != nil {
+		return Nil, err
+	}
+	return uuid, nil
+}
+
+// NewV7WithTime returns a Version 7 UUID for t instead of the current time, for
+// example to backfill records.  rand_a holds the sub millisecond precision of
+// t and the remaining bits are random.  UUIDs generated with NewV7WithTime do
+// not take part in the monotonic state of NewV7.  NewV7WithTime panics if t is
+// before the Unix epoch or beyond the 48 bit millisecond range.
+func NewV7WithTime(t time.Time) UUID {
+	return Must(defaultGenerator.NewV7WithTime(t))
+}
 
-	makeV7(uuid[:])
+// NewV7WithTime is like the package level NewV7WithTime but uses the random
+// number generator of g.  ErrTimeOutOfRange is returned if t cannot be
+// represented in a Version 7 UUID.
+func (g *Generator) NewV7WithTime(t time.Time) (UUID, error) {
+	milli := t.UnixMilli()
+	if milli < 0 || milli > v7MaxMilli {
+		return Nil, errors.ErrTimeOutOfRange
+	}
+	uuid, err := g.NewV4()
+	if err != nil {
+		return uuid, err
+	}
+	seq := int64(t.Nanosecond()%nanoPerMilli) >> 8
+	putV7Time(uuid[:], milli)
+	uuid[6] = 0x70 | (0x0F & byte(seq>>8))
+	uuid[7] = byte(seq)
 	return uuid, nil
 }
 
+// V7MinForTime returns the smallest Version 7 UUID with the millisecond of t,
+// all bits other than the timestamp, version and variant being zero.
+// Together with V7MaxForTime it turns a time window into a key range:
+//
+//	WHERE id BETWEEN V7MinForTime(from) AND V7MaxForTime(to)
+//
+// Times outside the 48 bit millisecond range are clamped.
+func V7MinForTime(t time.Time) UUID {
+	var uuid UUID
+	putV7Time(uuid[:], min(max(t.UnixMilli(), 0), v7MaxMilli))
+	uuid.SetVersion(V7)
+	uuid.SetVariant(RFC4122)
+	return uuid
+}
+
+// V7MaxForTime returns the largest Version 7 UUID with the millisecond of t,
+// all bits other than the timestamp, version and variant being one.  See
+// V7MinForTime.
+func V7MaxForTime(t time.Time) UUID {
+	uuid := Max
+	putV7Time(uuid[:], min(max(t.UnixMilli(), 0), v7MaxMilli))
+	uuid.SetVersion(V7)
+	uuid.SetVariant(RFC4122)
+	return uuid
+}
+
+// v7MaxMilli is the largest millisecond timestamp of a Version 7 UUID.
+const v7MaxMilli = 1<<48 - 1
+
+// putV7Time writes the 48 bit millisecond timestamp t to uuid[0] - uuid[5].
+func putV7Time(uuid []byte, t int64) {
+	uuid[0] = byte(t >> 40)
+	uuid[1] = byte(t >> 32)
+	uuid[2] = byte(t >> 24)
+	uuid[3] = byte(t >> 16)
+	uuid[4] = byte(t >> 8)
+	uuid[5] = byte(t)
+}
+
 // makeV7 fill 48 bits time (uuid[0] - uuid[5]), set version b0111 (uuid[6])
+// and fills rand_a and rand_b according to g.v7Method, reading additional
+// random data from r when needed.
 // uuid[8] already has the right version number (Variant is 10)
 // see function NewV7 and NewV7FromReader
-func makeV7(uuid []byte) {
+func (g *Generator) makeV7(uuid []byte, r io.Reader) error {
 	/*
 		 0                   1                   2                   3
 		 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
@@ -59,44 +161,84 @@ func makeV7(uuid []byte) {
 	*/
 	_ = uuid[15] // bounds check
 
-	t, s := getV7Time()
+	g.timeMu.Lock()
+	defer g.timeMu.Unlock()
 
-	uuid[0] = byte(t >> 40)
-	uuid[1] = byte(t >> 32)
-	uuid[2] = byte(t >> 24)
-	uuid[3] = byte(t >> 16)
-	uuid[4] = byte(t >> 8)
-	uuid[5] = byte(t)
+	var t int64
+	switch g.v7Method {
+	case V7DedicatedCounter:
+		var c uint64
+		var err error
+		if t, c, err = g.getV7Counter(uuid); err != nil {
+			return err
+		}
+		var v v7Rand
+		v.SetBytes(uuid)
+		v.SetCounter(c, g.v7CounterBits)
+		v.AppendTo(uuid)
+	case V7MonotonicRandom:
+		var err error
+		if t, err = g.getV7MonotonicRandom(uuid, r); err != nil {
+			return err
+		}
+		g.v7Rand.AppendTo(uuid)
+	default:
+		var s int64
+		var err error
+		if t, s, err = g.getV7Time(); err != nil {
+			return err
+		}
+		uuid[6] = byte(s >> 8)
+		uuid[7] = byte(s)
+	}
 
-	uuid[6] = 0x70 | (0x0F & byte(s>>8))
-	uuid[7] = byte(s)
+	putV7Time(uuid, t)
+	uuid[6] = 0x70 | (0x0F & uuid[6])
+	return nil
 }
 
-// lastV7time is the last time we returned stored as:
-//
-//	52 bits of time in milliseconds since epoch
-//	12 bits of (fractional nanoseconds) >> 8
-var lastV7time int64
-
 const nanoPerMilli = 1000000
 
 // getV7Time returns the time in milliseconds and nanoseconds / 256.
 // The returned (milli << 12 + seq) is guaranteed to be greater than
 // (milli << 12 + seq) returned by any previous call to getV7Time.
-func getV7Time() (milli, seq int64) {
-	timeMu.Lock()
-	defer timeMu.Unlock()
-
-	nano := timeNow().UnixNano()
+// If that would require moving past the millisecond of the previous call,
+// ErrV7Overflow is returned instead.
+//
+// g.lastV7time is the last time we returned stored as:
+//
+//	52 bits of time in milliseconds since epoch
+//	12 bits of (fractional nanoseconds) >> 8
+//
+// getV7Time must be called with g.timeMu held.
+func (g *Generator) getV7Time() (milli, seq int64, err error) {
+	t := g.now()
+	nano := t.UnixNano()
 	milli = nano / nanoPerMilli
 	// Sequence number is between 0 and 3906 (nanoPerMilli>>8)
 	seq = (nano - milli*nanoPerMilli) >> 8
 	now := milli<<12 + seq
-	if now <= lastV7time {
-		now = lastV7time + 1
+	// Only a clock reading older than the previous one is a regression, not
+	// one that is merely behind an incremented seq.
+	if now < g.lastV7read {
+		last := (g.lastV7read>>12)*nanoPerMilli + (g.lastV7read&0xfff)<<8
+		if t, err = g.regressed(Version(V7), time.Unix(0, last), t); err != nil {
+			return 0, 0, err
+		}
+		nano = t.UnixNano()
+		milli = nano / nanoPerMilli
+		seq = (nano - milli*nanoPerMilli) >> 8
+		now = milli<<12 + seq
+	}
+	g.lastV7read = max(g.lastV7read, now)
+	if now <= g.lastV7time {
+		now = g.lastV7time + 1
+		if now>>12 != g.lastV7time>>12 {
+			return 0, 0, errors.ErrV7Overflow
+		}
 		milli = now >> 12
 		seq = now & 0xfff
 	}
-	lastV7time = now
-	return milli, seq
+	g.lastV7time = now
+	return milli, seq, nil
 }
@@ -0,0 +1,200 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"time"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+// A V7Method selects how a Generator keeps Version 7 UUIDs generated within the
+// same millisecond monotonic, see RFC 9562 section 6.2.
+//
+// see https://datatracker.ietf.org/doc/html/rfc9562#name-monotonicity-and-counters
+type V7Method byte
+
+const (
+	// V7ClockPrecision (method 3) replaces rand_a with 12 bits of sub
+	// millisecond clock precision.  When the clock does not advance the
+	// previous value is incremented.  This is the default.
+	V7ClockPrecision = V7Method(iota)
+	// V7DedicatedCounter (method 1) uses a fixed length counter stored in
+	// rand_a and, for counters wider than 12 bits, the leftmost bits of
+	// rand_b.  The counter is seeded with random bits with its most
+	// significant bit cleared each millisecond and incremented by one within
+	// it.  The remaining bits of rand_b are random.
+	V7DedicatedCounter
+	// V7MonotonicRandom (method 2) treats the 74 bits of rand_a and rand_b as
+	// a random number that is incremented by a random amount within the same
+	// millisecond, like the monotonic entropy of package ulid.
+	V7MonotonicRandom
+)
+
+const (
+	v7MinCounterBits = 12
+	v7MaxCounterBits = 42
+)
+
+func (m V7Method) String() string {
+	switch m {
+	case V7ClockPrecision:
+		return "ClockPrecision"
+	case V7DedicatedCounter:
+		return "DedicatedCounter"
+	case V7MonotonicRandom:
+		return "MonotonicRandom"
+	}
+	return fmt.Sprintf("V7Method%d", int(m))
+}
+
+// WithV7Method sets the method used to keep Version 7 UUIDs monotonic within a
+// millisecond.  By default V7ClockPrecision is used.
+func WithV7Method(m V7Method) Option {
+	return func(g *Generator) {
+		g.v7Method = m
+	}
+}
+
+// WithV7CounterBits sets the width of the V7DedicatedCounter counter.  RFC 9562
+// recommends between 12 and 42 bits, n is clamped to that range.  A counter of
+// n bits allows at least 2^(n-1) UUIDs per millisecond.  By default 12 bits
+// are used.
+func WithV7CounterBits(n int) Option {
+	return func(g *Generator) {
+		g.v7CounterBits = min(max(n, v7MinCounterBits), v7MaxCounterBits)
+	}
+}
+
+// WithV7Increment sets the maximum random increment used by V7MonotonicRandom.
+// Passing 0 results in the default math.MaxUint32.  Lower values allow more
+// UUIDs per millisecond at the cost of easier guessability.
+func WithV7Increment(inc uint64) Option {
+	return func(g *Generator) {
+		if inc == 0 {
+			inc = math.MaxUint32
+		}
+		g.v7Inc = inc
+	}
+}
+
+// getV7Counter returns the time in milliseconds and the V7DedicatedCounter
+// counter for uuid, seeding the counter from the random bits of uuid when the
+// millisecond changed.  ErrV7Overflow is returned when the counter is
+// exhausted.
+//
+// getV7Counter must be called with g.timeMu held.
+func (g *Generator) getV7Counter(uuid []byte) (milli int64, counter uint64, err error) {
+	if milli, err = g.v7Milli(); err != nil {
+		return 0, 0, err
+	}
+	if milli > g.v7ms {
+		var v v7Rand
+		v.SetBytes(uuid)
+		counter = v.Counter(g.v7CounterBits) &^ (1 << (g.v7CounterBits - 1))
+		g.v7ms, g.v7Counter = milli, counter
+		return milli, counter, nil
+	}
+
+	counter = g.v7Counter + 1
+	if counter>>g.v7CounterBits != 0 {
+		return 0, 0, errors.ErrV7Overflow
+	}
+	g.v7Counter = counter
+	return g.v7ms, counter, nil
+}
+
+// getV7MonotonicRandom returns the time in milliseconds and updates g.v7Rand,
+// either to the random bits of uuid when the millisecond changed or by
+// incrementing it with a random number read from r.  ErrV7Overflow is returned
+// when the increment overflows the 74 available bits.
+//
+// getV7MonotonicRandom must be called with g.timeMu held.
+func (g *Generator) getV7MonotonicRandom(uuid []byte, r io.Reader) (milli int64, err error) {
+	if milli, err = g.v7Milli(); err != nil {
+		return 0, err
+	}
+	if milli > g.v7ms {
+		g.v7Rand.SetBytes(uuid)
+		g.v7ms = milli
+		return milli, nil
+	}
+
+	inc := uint64(1)
+	if g.v7Inc > 1 {
+		var b [8]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return 0, err
+		}
+		inc += binary.BigEndian.Uint64(b[:]) % g.v7Inc
+	}
+	v := g.v7Rand
+	if v.Add(inc) {
+		return 0, errors.ErrV7Overflow
+	}
+	g.v7Rand = v
+	return g.v7ms, nil
+}
+
+// v7Milli returns the current time in milliseconds for the counter methods,
+// applying the regression policy of g when it is before the millisecond of the
+// previous UUID.
+//
+// v7Milli must be called with g.timeMu held.
+func (g *Generator) v7Milli() (int64, error) {
+	t := g.now()
+	if t.UnixMilli() < g.v7ms {
+		var err error
+		if t, err = g.regressed(Version(V7), time.UnixMilli(g.v7ms), t); err != nil {
+			return 0, err
+		}
+	}
+	return t.UnixMilli(), nil
+}
+
+// v7Rand holds the 12 bits of rand_a followed by the 62 bits of rand_b of a
+// Version 7 UUID as a single 74 bit number.
+type v7Rand struct {
+	Hi uint16 // 10 most significant bits
+	Lo uint64
+}
+
+const v7RandBMask = 1<<62 - 1
+
+func (v *v7Rand) SetBytes(uuid []byte) {
+	a := uint64(uuid[6]&0x0f)<<8 | uint64(uuid[7])
+	v.Hi = uint16(a >> 2)
+	v.Lo = a<<62 | binary.BigEndian.Uint64(uuid[8:16])&v7RandBMask
+}
+
+// AppendTo writes v to rand_a and rand_b of uuid, keeping its version and
+// variant bits.
+func (v *v7Rand) AppendTo(uuid []byte) {
+	a := uint64(v.Hi)<<2 | v.Lo>>62
+	uuid[6] = uuid[6]&0xf0 | byte(a>>8)
+	uuid[7] = byte(a)
+	binary.BigEndian.PutUint64(uuid[8:16], uint64(uuid[8]&0xc0)<<56|v.Lo&v7RandBMask)
+}
+
+func (v *v7Rand) Add(n uint64) (overflow bool) {
+	var carry uint64
+	v.Lo, carry = bits.Add64(v.Lo, n, 0)
+	v.Hi += uint16(carry)
+	return v.Hi > 0x3ff
+}
+
+// Counter returns the n most significant bits of v.
+func (v *v7Rand) Counter(n int) uint64 {
+	s := 74 - n
+	return uint64(v.Hi)<<(64-s) | v.Lo>>s
+}
+
+// SetCounter replaces the n most significant bits of v with c.
+func (v *v7Rand) SetCounter(c uint64, n int) {
+	s := 74 - n
+	v.Hi = uint16(c >> (64 - s))
+	v.Lo = v.Lo&(1<<s-1) | c<<s
+}
@@ -0,0 +1,120 @@
+package uuid
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fajarnugraha37/goid/clock"
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+func TestNewV7BurstWithinOneTick(t *testing.T) {
+	regressions := 0
+	start := time.UnixMilli(1700000000000).Add(500 * time.Microsecond)
+	c := clock.NewFake(start, 0)
+	g := NewGenerator(
+		WithClock(c),
+		WithRegressionPolicy(RegressionError),
+		WithRegressionHook(func(RegressionEvent) { regressions++ }),
+	)
+
+	var last UUID
+	for i := 0; ; i++ {
+		u, err := g.NewV7()
+		if err == errors.ErrV7Overflow {
+			break
+		}
+		if err != nil {
+			t.Fatalf("NewV7 #%d: %v", i, err)
+		}
+		if i >= 4096 {
+			t.Fatal("NewV7 did not overflow within 4096 calls")
+		}
+		if Compare(u, last) <= 0 {
+			t.Fatalf("NewV7 #%d: %s is not greater than %s", i, u, last)
+		}
+		// The timestamp must never creep ahead of the clock.
+		if min := V7MinForTime(start); [6]byte(u[:6]) != [6]byte(min[:6]) {
+			t.Fatalf("NewV7 #%d: %s is not stamped with the millisecond of the clock", i, u)
+		}
+		last = u
+	}
+
+	// Once the clock reaches the next millisecond generation resumes, and
+	// readings behind the incremented seq did not count as a regression.
+	c.Advance(time.Millisecond)
+	u, err := g.NewV7()
+	if err != nil {
+		t.Fatalf("NewV7 after advancing the clock: %v", err)
+	}
+	if Compare(u, last) <= 0 {
+		t.Fatalf("%s is not greater than %s", u, last)
+	}
+	if regressions != 0 {
+		t.Errorf("incremented seqs reported %d regressions", regressions)
+	}
+}
+
+func TestNewV7WaitsForNextMilli(t *testing.T) {
+	// A clock with millisecond resolution overflows after 4096 UUIDs.
+	g := NewGenerator(WithClock(clock.Func(func() time.Time {
+		return time.Now().Truncate(time.Millisecond)
+	})))
+
+	var last UUID
+	for i := 0; i < 3*4096; i++ {
+		u, err := g.newV7Wait()
+		if err != nil {
+			t.Fatalf("newV7Wait #%d: %v", i, err)
+		}
+		if Compare(u, last) <= 0 {
+			t.Fatalf("newV7Wait #%d: %s is not greater than %s", i, u, last)
+		}
+		last = u
+	}
+}
+
+func TestNewV7WithTimeBeyond2262(t *testing.T) {
+	tm := time.Date(3000, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	u, err := NewGenerator().NewV7WithTime(tm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if min := V7MinForTime(tm); [6]byte(u[:6]) != [6]byte(min[:6]) {
+		t.Errorf("NewV7WithTime(%v) = %s, want the timestamp of %s", tm, u, min)
+	}
+	if seq, want := int(u[6]&0x0f)<<8|int(u[7]), 456789>>8; seq != want {
+		t.Errorf("NewV7WithTime(%v) has rand_a %d, want %d", tm, seq, want)
+	}
+}
+
+func TestNewV7ConcurrentBurst(t *testing.T) {
+	const goroutines, n = 8, 5000
+
+	var wg sync.WaitGroup
+	ids := make([][]UUID, goroutines)
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < n; j++ {
+				ids[i] = append(ids[i], NewV7())
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	seen := make(map[UUID]bool, goroutines*n)
+	for _, list := range ids {
+		for j, u := range list {
+			if seen[u] {
+				t.Fatalf("duplicate UUID %s", u)
+			}
+			seen[u] = true
+			if j > 0 && Compare(u, list[j-1]) <= 0 {
+				t.Fatalf("%s is not greater than %s", u, list[j-1])
+			}
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package uuid
+
+import (
+	"io"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+// UUID version 8 provides an RFC-compatible format for experimental or
+// vendor-specific use cases. The only requirement is that the variant and
+// version bits MUST be set, the remaining 122 bits are laid out by the
+// implementation.
+//
+// see https://datatracker.ietf.org/doc/html/rfc9562#name-uuid-version-8
+//
+// NewV8 returns a Version 8 UUID built from custom, with the version and
+// variant bits overwritten.
+func NewV8(custom [16]byte) UUID {
+	/*
+		 0                   1                   2                   3
+		 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
+		+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+		|                           custom_a                            |
+		+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+		|          custom_a             |  ver  |       custom_b        |
+		+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+		|var|                       custom_c                            |
+		+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+		|                           custom_c                            |
+		+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+	*/
+	uuid := UUID(custom)
+	uuid.SetVersion(V8)
+	uuid.SetVariant(RFC4122)
+	return uuid
+}
+
+// v8CustomBits is the number of bits of a Version 8 UUID that are not taken
+// by the version and variant.
+const v8CustomBits = 122
+
+// A V8Layout splits the custom bits of a Version 8 UUID into a Unix
+// millisecond timestamp, a shard ID, a counter and random bits, in that order
+// from the most significant bit.  The version and variant bits are skipped, so
+// a field may straddle them.  Custom bits not covered by the layout are zero.
+type V8Layout struct {
+	TimestampBits int
+	ShardBits     int
+	CounterBits   int
+	RandomBits    int
+}
+
+// V8Fields holds the decoded fields of a Version 8 UUID for a V8Layout.
+//
+// Random holds the random bits right aligned in big endian order, it is
+// (RandomBits+7)/8 bytes long.
+type V8Fields struct {
+	Timestamp uint64
+	Shard     uint64
+	Counter   uint64
+	Random    []byte
+}
+
+// NewV8Layout returns a V8Layout with the given field widths.  The timestamp,
+// shard and counter fields can be at most 64 bits wide and all fields together
+// must fit in the 122 custom bits of a Version 8 UUID.
+//
+// For example, a 48 bit timestamp, a 10 bit shard ID, a 12 bit counter and 52
+// random bits:
+//
+//	layout, err := NewV8Layout(48, 10, 12, 52)
+func NewV8Layout(timestampBits, shardBits, counterBits, randomBits int) (V8Layout, error) {
+	l := V8Layout{
+		TimestampBits: timestampBits,
+		ShardBits:     shardBits,
+		CounterBits:   counterBits,
+		RandomBits:    randomBits,
+	}
+	return l, l.validate()
+}
+
+func (l V8Layout) validate() error {
+	for _, n := range [...]int{l.TimestampBits, l.ShardBits, l.CounterBits} {
+		if n < 0 || n > 64 {
+			return errors.V8LayoutError{Bits: n}
+		}
+	}
+	if l.RandomBits < 0 {
+		return errors.V8LayoutError{Bits: l.RandomBits}
+	}
+	if n := l.TimestampBits + l.ShardBits + l.CounterBits + l.RandomBits; n > v8CustomBits {
+		return errors.V8LayoutError{Bits: n}
+	}
+	return nil
+}
+
+// Encode returns the Version 8 UUID holding f.  An error is returned if the
+// layout is invalid, or if the shard, counter or random bits do not fit in
+// their fields.  The timestamp is truncated to its least significant
+// TimestampBits bits.
+func (l V8Layout) Encode(f V8Fields) (UUID, error) {
+	var uuid UUID
+	if err := l.validate(); err != nil {
+		return Nil, err
+	}
+	if !fitsBits(f.Shard, l.ShardBits) {
+		return Nil, errors.V8FieldOverflowError{Field: "shard", Bits: l.ShardBits}
+	}
+	if !fitsBits(f.Counter, l.CounterBits) {
+		return Nil, errors.V8FieldOverflowError{Field: "counter", Bits: l.CounterBits}
+	}
+	if len(f.Random) != (l.RandomBits+7)/8 || (len(f.Random) > 0 && !fitsBits(uint64(f.Random[0]), l.RandomBits-(len(f.Random)-1)*8)) {
+		return Nil, errors.V8FieldOverflowError{Field: "random", Bits: l.RandomBits}
+	}
+
+	off := 0
+	off = putV8Bits(&uuid, off, l.TimestampBits, f.Timestamp)
+	off = putV8Bits(&uuid, off, l.ShardBits, f.Shard)
+	off = putV8Bits(&uuid, off, l.CounterBits, f.Counter)
+	for i := l.RandomBits - 1; i >= 0; i-- {
+		b := f.Random[len(f.Random)-1-i/8] >> (i % 8)
+		off = putV8Bits(&uuid, off, 1, uint64(b))
+	}
+
+	uuid.SetVersion(V8)
+	uuid.SetVariant(RFC4122)
+	return uuid, nil
+}
+
+// Decode returns the fields of uuid for the layout.  An error is returned if
+// the layout is invalid.  The version and variant of uuid are not checked.
+func (l V8Layout) Decode(uuid UUID) (V8Fields, error) {
+	var f V8Fields
+	if err := l.validate(); err != nil {
+		return f, err
+	}
+	off := 0
+	f.Timestamp, off = getV8Bits(uuid, off, l.TimestampBits)
+	f.Shard, off = getV8Bits(uuid, off, l.ShardBits)
+	f.Counter, off = getV8Bits(uuid, off, l.CounterBits)
+	f.Random = make([]byte, (l.RandomBits+7)/8)
+	for i := l.RandomBits - 1; i >= 0; i-- {
+		var b uint64
+		b, off = getV8Bits(uuid, off, 1)
+		f.Random[len(f.Random)-1-i/8] |= byte(b) << (i % 8)
+	}
+	return f, nil
+}
+
+// NewV8WithLayout returns a Version 8 UUID for layout holding the current
+// time, shard and counter, with the random bits read from the random number
+// generator.
+func NewV8WithLayout(layout V8Layout, shard, counter uint64) (UUID, error) {
+	return defaultGenerator.NewV8WithLayout(layout, shard, counter)
+}
+
+// NewV8WithLayout is like the package level NewV8WithLayout but uses the
+// clock and random number generator of g.
+func (g *Generator) NewV8WithLayout(layout V8Layout, shard, counter uint64) (UUID, error) {
+	if err := layout.validate(); err != nil {
+		return Nil, err
+	}
+	f := V8Fields{
+		Timestamp: uint64(g.now().UnixMilli()),
+		Shard:     shard,
+		Counter:   counter,
+		Random:    make([]byte, (layout.RandomBits+7)/8),
+	}
+	if _, err := io.ReadFull(g.rander, f.Random); err != nil {
+		return Nil, err
+	}
+	if len(f.Random) > 0 {
+		f.Random[0] &= byte(0xff >> ((8 - layout.RandomBits%8) % 8))
+	}
+	return layout.Encode(f)
+}
+
+// fitsBits reports whether v can be stored in n bits.
+func fitsBits(v uint64, n int) bool {
+	return n >= 64 || v>>n == 0
+}
+
+// v8BitPos maps the i'th custom bit of a Version 8 UUID to its bit position in
+// the UUID, skipping the version and variant bits.
+func v8BitPos(i int) int {
+	switch {
+	case i < 48:
+		return i
+	case i < 60:
+		return i + 4
+	default:
+		return i + 6
+	}
+}
+
+// putV8Bits writes the n least significant bits of v starting at custom bit
+// off and returns the offset following them.
+func putV8Bits(uuid *UUID, off, n int, v uint64) int {
+	for i := n - 1; i >= 0; i-- {
+		p := v8BitPos(off)
+		uuid[p/8] |= byte(v>>i&1) << (7 - p%8)
+		off++
+	}
+	return off
+}
+
+// getV8Bits reads n bits starting at custom bit off and returns them together
+// with the offset following them.
+func getV8Bits(uuid UUID, off, n int) (uint64, int) {
+	var v uint64
+	for i := 0; i < n; i++ {
+		p := v8BitPos(off)
+		v = v<<1 | uint64(uuid[p/8]>>(7-p%8)&1)
+		off++
+	}
+	return v, off
+}
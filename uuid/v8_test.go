@@ -0,0 +1,77 @@
+package uuid
+
+import (
+	"bytes"
+	e "errors"
+	"testing"
+
+	"github.com/fajarnugraha37/goid/errors"
+)
+
+func TestV8LayoutRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		layout V8Layout
+		fields V8Fields
+	}{
+		// 48 bit timestamp, 10 bit shard ID, 12 bit counter and 52 random
+		// bits: the shard straddles the version bits.
+		{
+			V8Layout{48, 10, 12, 52},
+			V8Fields{Timestamp: 0xfedcba987654, Shard: 0x3ff, Counter: 0xabc, Random: []byte{0x0f, 0xed, 0xcb, 0xa9, 0x87, 0x65, 0x43}},
+		},
+		// The timestamp runs past the version bits at 48-51 of the UUID and
+		// the counter, custom bits 58-65, spans the variant bits at 64-65.
+		{
+			V8Layout{52, 6, 8, 56},
+			V8Fields{Timestamp: 0xfffffffffffff, Shard: 0x2a, Counter: 0xff, Random: []byte{0x80, 1, 2, 3, 4, 5, 0x7f}},
+		},
+		{
+			V8Layout{64, 0, 58, 0},
+			V8Fields{Timestamp: 0x8000000000000001, Counter: 0x3ffffffffffffff, Random: []byte{}},
+		},
+	} {
+		uuid, err := test.layout.Encode(test.fields)
+		if err != nil {
+			t.Fatalf("%+v.Encode(%+v): %v", test.layout, test.fields, err)
+		}
+		if uuid.Version() != Version(V8) || uuid.Variant() != RFC4122 {
+			t.Errorf("%s: version %v, variant %v", uuid, uuid.Version(), uuid.Variant())
+		}
+		f, err := test.layout.Decode(uuid)
+		if err != nil {
+			t.Fatalf("%+v.Decode(%s): %v", test.layout, uuid, err)
+		}
+		if f.Timestamp != test.fields.Timestamp || f.Shard != test.fields.Shard || f.Counter != test.fields.Counter || !bytes.Equal(f.Random, test.fields.Random) {
+			t.Errorf("%+v.Decode(%s) = %+v, want %+v", test.layout, uuid, f, test.fields)
+		}
+	}
+}
+
+func TestV8LayoutBitPositions(t *testing.T) {
+	// A single set bit on either side of the version and variant bits.
+	l := V8Layout{TimestampBits: 48, CounterBits: 14}
+	uuid, err := l.Encode(V8Fields{Counter: 1<<13 | 1<<1 | 1, Random: []byte{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := MustParse("00000000-0000-8800-b000-000000000000")
+	if uuid != want {
+		t.Errorf("Encode = %s, want %s", uuid, want)
+	}
+}
+
+func TestV8LayoutInvalid(t *testing.T) {
+	for _, l := range []V8Layout{
+		{RandomBits: 200},
+		{TimestampBits: 65},
+		{ShardBits: -1},
+		{TimestampBits: 64, ShardBits: 64},
+	} {
+		if _, err := l.Decode(Max); !e.Is(err, errors.ErrInvalidV8Layout) {
+			t.Errorf("%+v.Decode = %v, want a V8LayoutError", l, err)
+		}
+		if _, err := l.Encode(V8Fields{}); !e.Is(err, errors.ErrInvalidV8Layout) {
+			t.Errorf("%+v.Encode = %v, want a V8LayoutError", l, err)
+		}
+	}
+}
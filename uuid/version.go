@@ -12,6 +12,9 @@ const (
 	V3
 	V4
 	V5
+	V6
+	V7
+	V8
 )
 
 func (v Version) String() string {